@@ -19,6 +19,50 @@ type SpecificConfig struct {
     AWSRegion string `yaml:"aws_region"` // 比如 "us-east-2"
     S3Bucket  string `yaml:"s3_bucket"`  // 比如 "project-talk-media"
     CDNDomain string `yaml:"cdn_domain"` // 比如 "cdn.skylar27.com",可留空
+
+    //回收站保留天数，超过后帖子被真正删除；<= 0 时用默认值
+    PostTrashRetentionDays int `yaml:"post_trash_retention_days"`
+
+    //待处理举报达到这个数时帖子自动从 feed 隐藏；<= 0 时用默认值
+    PostReportHideThreshold int `yaml:"post_report_hide_threshold"`
+    //可以处理举报的用户（版主）
+    ModeratorUserIDs []int64 `yaml:"moderator_user_ids"`
+
+    //发帖 / 编辑时的内容过滤（见 biz/pkg/contentfilter）
+    ContentFilter ContentFilterConfig `yaml:"content_filter"`
+
+    //按路由限流（token bucket，登录用户按 user id，未登录按 IP），key 是路由，比如 "/post/create"
+    //没有配置的路由不限流
+    RateLimits map[string]RateLimitConfig `yaml:"rate_limits"`
+    //前面反向代理的 IP / CIDR，只有来自这些地址的 X-Forwarded-For / X-Real-IP 才可信；为空时只用连接的远端地址
+    TrustedProxies []string `yaml:"trusted_proxies"`
+}
+
+// RateLimitConfig: 每 PeriodSeconds 秒 Requests 次，最多一次性 Burst 次（<= 0 时等于 Requests）
+type RateLimitConfig struct {
+    Requests      int `yaml:"requests"`
+    PeriodSeconds int `yaml:"period_seconds"`
+    Burst         int `yaml:"burst"`
+}
+
+// ContentFilterConfig: 各项 <= 0 时用默认值；action 可选 mask / review / reject
+type ContentFilterConfig struct {
+    MaxTitleLength   int `yaml:"max_title_length"`
+    MaxContentLength int `yaml:"max_content_length"`
+    MaxLinks         int `yaml:"max_links"`
+    LinkAction       string `yaml:"link_action"`
+    MaxRepeatedChars int `yaml:"max_repeated_chars"`
+    RepeatAction     string `yaml:"repeat_action"`
+
+    //所有学校都生效的违禁词
+    BannedWords BannedWordsConfig `yaml:"banned_words"`
+    //按 school id 额外生效的违禁词（比如面向中学生的学校更严格）
+    SchoolBannedWords map[int64]BannedWordsConfig `yaml:"school_banned_words"`
+}
+
+type BannedWordsConfig struct {
+    Words  []string `yaml:"words"`
+    Action string `yaml:"action"` //默认 mask
 }
 
 type GeneralConfig struct {
@@ -63,6 +107,12 @@ func GetSpecificConfig() SpecificConfig {
         AWSRegion: specificCfg.AWSRegion,
         S3Bucket:  specificCfg.S3Bucket,
         CDNDomain: specificCfg.CDNDomain,
+        PostTrashRetentionDays: specificCfg.PostTrashRetentionDays,
+        PostReportHideThreshold: specificCfg.PostReportHideThreshold,
+        ModeratorUserIDs: specificCfg.ModeratorUserIDs,
+        ContentFilter: specificCfg.ContentFilter,
+        RateLimits: specificCfg.RateLimits,
+        TrustedProxies: specificCfg.TrustedProxies,
     }
 }
 
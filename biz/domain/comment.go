@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"time"
+	thrift "zetian-personal-website-hertz/biz/model/comment"
+)
+
+// PostComment — database row model of a comment.
+//
+// Threading is two-level (like most feeds):
+//   - top-level comment: RootID = 0, ParentID = 0
+//   - reply:             RootID = top-level comment ID, ParentID = the comment being replied to
+type PostComment struct {
+	ID     int64 `json:"id" gorm:"primaryKey;autoIncrement"`
+	PostID int64 `json:"post_id" gorm:"not null;index:idx_post_comments_post_root,priority:1"`
+	UserID int64 `json:"user_id" gorm:"not null"`
+
+	RootID        int64 `json:"root_id" gorm:"not null;default:0;index:idx_post_comments_post_root,priority:2"`
+	ParentID      int64 `json:"parent_id" gorm:"not null;default:0"`
+	ReplyToUserID int64 `json:"reply_to_user_id" gorm:"not null;default:0"`
+
+	Content string `json:"content" gorm:"type:text"`
+
+	LikeCount  int32 `json:"like_count" gorm:"not null;default:0"`
+	ReplyCount int32 `json:"reply_count" gorm:"not null;default:0"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
+}
+
+// PostCommentLike represents a like relation between a user and a comment.
+type PostCommentLike struct {
+	UserID    int64     `json:"user_id" gorm:"primaryKey"`
+	CommentID int64     `json:"comment_id" gorm:"primaryKey"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Comment is the comment returned to clients: row + author info + viewer flags.
+type Comment struct {
+	PostComment
+
+	UserName        string `json:"user_name"`
+	UserAvatarUrl   string `json:"user_avatar_url"`
+	ReplyToUserName string `json:"reply_to_user_name"`
+	IsLikedByUser   bool   `json:"is_liked_by_user"`
+}
+
+// DomainCommentToThrift converts Comment → thrift.Comment.
+// Time is formatted using RFC3339Nano, same as posts.
+func DomainCommentToThrift(c Comment) thrift.Comment {
+	return thrift.Comment{
+		ID:              c.ID,
+		PostID:          c.PostID,
+		UserID:          c.UserID,
+		UserName:        c.UserName,
+		UserAvatarURL:   c.UserAvatarUrl,
+		RootID:          c.RootID,
+		ParentID:        c.ParentID,
+		ReplyToUserID:   c.ReplyToUserID,
+		ReplyToUserName: c.ReplyToUserName,
+		Content:         c.Content,
+		LikeCount:       c.LikeCount,
+		ReplyCount:      c.ReplyCount,
+		IsLikedByUser:   c.IsLikedByUser,
+		CreatedAt:       c.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+// DomainCommentListToThriftPointers converts []Comment → []*thrift.Comment.
+func DomainCommentListToThriftPointers(comments []Comment) []*thrift.Comment {
+	res := make([]*thrift.Comment, 0, len(comments))
+	for _, c := range comments {
+		tc := DomainCommentToThrift(c)
+		res = append(res, &tc)
+	}
+	return res
+}
@@ -4,14 +4,14 @@ import "time"
 
 // PostLike represents a like relation between a user and a post.
 type PostLike struct {
-	UserID    int64     `json:"user_id" gorm:"primaryKey"`
-	PostID    int64     `json:"post_id" gorm:"primaryKey"`
-	CreatedAt time.Time `json:"created_at"`
+	UserID    int64     `json:"user_id" gorm:"primaryKey;index:idx_post_likes_user_created,priority:1"`
+	PostID    int64     `json:"post_id" gorm:"primaryKey;index:idx_post_likes_post_created,priority:1"`
+	CreatedAt time.Time `json:"created_at" gorm:"index:idx_post_likes_user_created,priority:2,sort:desc;index:idx_post_likes_post_created,priority:2,sort:desc"` // "我赞过的" / "谁赞了" 都按这个排序
 }
 
 // PostFavorite represents a favorite relation between a user and a post.
 type PostFavorite struct {
-	UserID    int64     `json:"user_id" gorm:"primaryKey"`
+	UserID    int64     `json:"user_id" gorm:"primaryKey;index:idx_post_favorites_user_created,priority:1"`
 	PostID    int64     `json:"post_id" gorm:"primaryKey"`
-	CreatedAt time.Time `json:"created_at"`
+	CreatedAt time.Time `json:"created_at" gorm:"index:idx_post_favorites_user_created,priority:2,sort:desc"` // "我的收藏" 按这个排序
 }
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+	thrift "zetian-personal-website-hertz/biz/model/post"
+)
+
+// Poll — a question attached to a post (at most one per post).
+// TotalVoters counts users, so with multi-choice it can be smaller than the sum of VoteCount.
+type Poll struct {
+	PostID      int64      `json:"post_id" gorm:"primaryKey"`
+	Question    string     `json:"question" gorm:"type:varchar(255);not null"`
+	MultiChoice bool       `json:"multi_choice" gorm:"not null;default:false"`
+	ClosesAt    *time.Time `json:"closes_at"` // nil = 永不关闭
+	TotalVoters int64      `json:"total_voters" gorm:"not null;default:0"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"autoCreateTime"`
+
+	Options []PollOption `json:"options" gorm:"foreignKey:PostID;references:PostID"`
+}
+
+// PollOption — one choice of a poll, Position keeps the order the author gave.
+type PollOption struct {
+	ID        int64  `json:"id" gorm:"primaryKey;autoIncrement"`
+	PostID    int64  `json:"post_id" gorm:"not null;uniqueIndex:idx_poll_options_post_position,priority:1"`
+	Position  int32  `json:"position" gorm:"not null;uniqueIndex:idx_poll_options_post_position,priority:2"`
+	Text      string `json:"text" gorm:"type:varchar(100);not null"`
+	VoteCount int64  `json:"vote_count" gorm:"not null;default:0"`
+}
+
+// PollVote — a user has voted on a poll. The primary key is what enforces one vote per user.
+type PollVote struct {
+	PostID    int64     `json:"post_id" gorm:"primaryKey"`
+	UserID    int64     `json:"user_id" gorm:"primaryKey"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// PollVoteChoice — an option chosen in a PollVote (several rows for multi-choice polls).
+type PollVoteChoice struct {
+	PostID   int64 `json:"post_id" gorm:"primaryKey"`
+	UserID   int64 `json:"user_id" gorm:"primaryKey"`
+	OptionID int64 `json:"option_id" gorm:"primaryKey"`
+}
+
+// PollView is a poll as seen by one viewer.
+type PollView struct {
+	Poll
+	Voted       bool    `json:"voted"`
+	MyOptionIDs []int64 `json:"my_option_ids"`
+}
+
+// IsClosed reports whether voting has ended at now.
+func (p Poll) IsClosed(now time.Time) bool {
+	return p.ClosesAt != nil && !now.Before(*p.ClosesAt)
+}
+
+func DomainPollViewToThrift(v PollView) *thrift.Poll {
+	options := make([]*thrift.PollOption, 0, len(v.Options))
+	for _, o := range v.Options {
+		options = append(options, &thrift.PollOption{
+			ID:        o.ID,
+			Text:      o.Text,
+			VoteCount: o.VoteCount,
+		})
+	}
+	myOptionIDs := v.MyOptionIDs
+	if myOptionIDs == nil {
+		myOptionIDs = []int64{}
+	}
+
+	return &thrift.Poll{
+		Question:    v.Question,
+		Options:     options,
+		MultiChoice: v.MultiChoice,
+		ClosesAt:    formatOptionalTime(v.ClosesAt),
+		Closed:      v.IsClosed(time.Now()),
+		TotalVoters: v.TotalVoters,
+		Voted:       v.Voted,
+		MyOptionIds: myOptionIDs,
+	}
+}
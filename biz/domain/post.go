@@ -4,15 +4,48 @@ import (
 	"encoding/json"
 	"time"
 	thrift "zetian-personal-website-hertz/biz/model/post"
+
+	"gorm.io/gorm"
+)
+
+// PostBase.Status values
+const (
+	PostStatusDraft     = "draft"     // 只有作者能看到
+	PostStatusScheduled = "scheduled" // 到 PublishAt 时由 scheduler 自动发布
+	PostStatusPublished = "published"
+)
+
+// PostBase.Visibility values
+const (
+	PostVisibilityPublic    = "public"
+	PostVisibilityFollowers = "followers" // 作者本人 + 关注了作者的人
+	PostVisibilitySchool    = "school"    // 作者本人 + 学校和帖子 school_id 相同的人
 )
 
+// IsValidPostVisibility reports whether v is one of PostVisibility*.
+func IsValidPostVisibility(v string) bool {
+	switch v {
+	case PostVisibilityPublic, PostVisibilityFollowers, PostVisibilitySchool:
+		return true
+	}
+	return false
+}
+
+// PostViewer is who is reading posts, visibility is checked against it.
+//   - UserID <= 0: not logged in, only public posts.
+//   - SchoolID == 0: the viewer has not set a school.
+type PostViewer struct {
+	UserID   int64
+	SchoolID int64
+}
+
 // PostBase — database row model
 type PostBase struct {
 	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement"`
 	UserID    int64     `json:"user_id"`
-	SchoolID  int64     `json:"school_id"`
+	SchoolID  int64     `json:"school_id" gorm:"index:idx_post_bases_school_category_created,priority:1"`
 
-	CategoryID int64    `json:"category_id"`
+	CategoryID int64    `json:"category_id" gorm:"index:idx_post_bases_school_category_created,priority:2"`
 
 	Title     string    `json:"title" gorm:"type:varchar(255)"`
 	Content   string    `json:"content" gorm:"type:text"`
@@ -20,14 +53,48 @@ type PostBase struct {
 	MediaType string    `json:"media_type" gorm:"type:varchar(50)"`
 	MediaUrls string    `json:"media_urls" gorm:"type:text"` // 存 JSON 字符串，[]string
 
-	Location *string    `json:"location" gorm:"type:varchar(255)"`
+	Location *string    `json:"location" gorm:"type:varchar(255)"` // 自由文本，只用来显示
+
+	// 坐标（可选），保存前已四舍五入（pkg/geo.CoordPrecision），不存精确位置
+	Latitude  *float64 `json:"latitude" gorm:"type:double precision"`
+	Longitude *float64 `json:"longitude" gorm:"type:double precision"`
+	// nearby feed 用，由 Postgres 根据 latitude / longitude 自动生成，代码里不读也不写
+	GeoPoint string `json:"-" gorm:"->:false;<-:false;type:point GENERATED ALWAYS AS (CASE WHEN latitude IS NULL OR longitude IS NULL THEN NULL ELSE point(longitude, latitude) END) STORED;index:idx_post_bases_geo_point,type:gist"`
 	Tags     string     `json:"tags" gorm:"type:text"` // 存 JSON 字符串，[]string
 
-	ReplyTo *int64      `json:"reply_to" gorm:"default:null"`
+	ReplyTo *int64      `json:"reply_to" gorm:"default:null;index"` // 引用的帖子，反查见 /post/quotes
+
+	EditCount int32     `json:"edit_count" gorm:"not null;default:0"` // 每次编辑 +1，历史版本在 post_revisions
+
+	// 草稿 / 定时发布：只有 published 的帖子会出现在 feed 里
+	Status    string     `json:"status" gorm:"type:varchar(16);not null;default:'published';index"`
+	PublishAt *time.Time `json:"publish_at" gorm:"index"` // scheduled: 计划发布时间；published: 实际发布时间
+
+	Visibility string `json:"visibility" gorm:"type:varchar(16);not null;default:'public'"`
+
+	// 被版主隐藏（或举报数达到阈值自动隐藏）：不再出现在 feed 里，只有作者还能看到
+	Hidden bool `json:"hidden" gorm:"not null;default:false"`
+	// 被版主删除（举报处理 action=delete）：和普通删除一样进回收站、到期清理，但作者看不到也恢复不了
+	RemovedByModerator bool `json:"removed_by_moderator" gorm:"not null;default:false"`
 
-	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+	// 置顶到个人主页的时间，nil = 没置顶；每人最多 3 条，个人主页第一页按置顶时间倒序排在最前
+	PinnedAt *time.Time `json:"pinned_at" gorm:"index"`
+
+	// 全文搜索用，由 Postgres 根据 title / tags / content 自动生成，代码里不读也不写
+	SearchVector string `json:"-" gorm:"->:false;<-:false;type:tsvector GENERATED ALWAYS AS (setweight(to_tsvector('simple', coalesce(title, '')), 'A') || setweight(to_tsvector('simple', coalesce(tags, '')), 'B') || setweight(to_tsvector('simple', coalesce(content, '')), 'C')) STORED;index:idx_post_bases_search_vector,type:gin"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime;index:idx_post_bases_school_category_created,priority:3,sort:desc"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"autoUpdateTime"`
 
+	// 软删除：非空表示在回收站里，所有普通查询自动过滤；超过保留期后由 purger 真正删除
+	DeletedAt gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+
+}
+
+// IsListed reports whether the post shows up for everyone (feeds, tags, search):
+// published and not hidden by moderation.
+func (b PostBase) IsListed() bool {
+	return b.Status == PostStatusPublished && !b.Hidden
 }
 
 //Post's stats
@@ -37,7 +104,7 @@ type PostStats struct {
 	LikeCount     int32 `json:"like_count"`
 	FavCount      int32 `json:"fav_count"`
 	CommentCount  int32 `json:"comment_count"`
-	ShareCount    int32 `json:"share_count"`
+	ShareCount    int32 `json:"share_count"` // 被引用（reply_to）的次数，只算已发布且不在回收站里的帖子
 	LastCommentAt int64 `json:"last_comment_at"`
 	HotScore      int64 `json:"hot_score"`
 }
@@ -52,6 +119,15 @@ type Post struct {
 	UserAvatarUrl string `json:"user_avatar_url" gorm:"-"`
 	IsLikedByUser bool   `json:"is_liked_by_user"`
 	IsFavByUser   bool   `json:"is_fav_by_user"`
+
+	// quoted post the viewer is not allowed to see, only ID / Visibility are set
+	Unavailable bool `json:"unavailable"`
+
+	Poll *PollView `json:"poll"` // nil = 没有投票
+
+	DistanceM *float64 `json:"distance_m"` // 只有 nearby feed 会填
+
+	Mentions []PostMention `json:"mentions"` // 按 start 排序
 }
 
 
@@ -110,6 +186,8 @@ func ToDomainPostBase(tp thrift.Post) PostBase {
 		// pointer: nil means "not a reply"
 		ReplyTo: tp.ReplyTo,
 
+		EditCount: tp.EditCount,
+
 		CreatedAt: createdAt,
 		UpdatedAt: updatedAt,
 	}
@@ -195,6 +273,8 @@ func CombineToThriftPost(
 		MediaType:  base.MediaType,
 		MediaUrls:  mediaUrls,
 		Location:   base.Location,
+		Latitude:   base.Latitude,
+		Longitude:  base.Longitude,
 		Tags:       tags,
 		ReplyTo:    base.ReplyTo,
 
@@ -211,6 +291,16 @@ func CombineToThriftPost(
 		LastCommentAt: stats.LastCommentAt,
 		HotScore:      stats.HotScore,
 
+		Edited:    base.EditCount > 0,
+		EditCount: base.EditCount,
+
+		Status:    base.Status,
+		PublishAt: formatOptionalTime(base.PublishAt),
+
+		Visibility: base.Visibility,
+		Hidden:     base.Hidden,
+		IsPinned:   base.PinnedAt != nil,
+
 		// User interaction flags (not stored in DB)
 		IsLikedByUser: liked,
 		IsFavByUser:   faved,
@@ -226,7 +316,14 @@ func CombineToThriftPost(
 
 // DomainPostToThrift is a convenience wrapper for a complete Post struct.
 func DomainPostToThrift(p Post) thrift.Post {
-	return CombineToThriftPost(
+	if p.Unavailable {
+		return thrift.Post{
+			ID:          p.ID,
+			Visibility:  p.Visibility,
+			Unavailable: true,
+		}
+	}
+	tp := CombineToThriftPost(
 		p.PostBase,
 		p.PostStats,
 		p.SchoolName,
@@ -236,6 +333,12 @@ func DomainPostToThrift(p Post) thrift.Post {
 		p.UserAvatarUrl,
 		p.CategoryName,
 	)
+	if p.Poll != nil {
+		tp.Poll = DomainPollViewToThrift(*p.Poll)
+	}
+	tp.DistanceM = p.DistanceM
+	tp.Mentions = DomainPostMentionListToThrift(p.Mentions)
+	return tp
 }
 
 // -----------------------------------------------------------------------------
@@ -298,3 +401,32 @@ func DomainPostMapToThriftPointerMap(m map[int64]Post) map[int64]*thrift.Post {
 }
 
 
+
+// TrashedPost is a soft-deleted post shown in its author's trash.
+type TrashedPost struct {
+	Post
+	PurgeAt time.Time `json:"purge_at"` // DeletedAt + retention
+}
+
+// DomainTrashedPostListToThriftPointers converts []TrashedPost → []*thrift.TrashedPost.
+func DomainTrashedPostListToThriftPointers(list []TrashedPost) []*thrift.TrashedPost {
+	res := make([]*thrift.TrashedPost, 0, len(list))
+	for _, tp := range list {
+		p := DomainPostToThrift(tp.Post)
+		res = append(res, &thrift.TrashedPost{
+			Post:      &p,
+			DeletedAt: tp.PostBase.DeletedAt.Time.Format(time.RFC3339Nano),
+			PurgeAt:   tp.PurgeAt.Format(time.RFC3339Nano),
+		})
+	}
+	return res
+}
+
+// formatOptionalTime formats a nullable time as RFC3339Nano, nil stays nil.
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339Nano)
+	return &s
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+	thrift "zetian-personal-website-hertz/biz/model/post"
+)
+
+// PostMention — an @username in a post's Content that resolved to a user.
+// Start / End are rune offsets of the whole token ("@" included) in Content, [Start, End).
+// A user mentioned twice in one post has two rows.
+type PostMention struct {
+	ID       int64  `json:"id" gorm:"primaryKey;autoIncrement"`
+	PostID   int64  `json:"post_id" gorm:"not null;uniqueIndex:idx_post_mentions_post_start,priority:1;index:idx_post_mentions_user_post,priority:2"`
+	UserID   int64  `json:"user_id" gorm:"not null;index:idx_post_mentions_user_post,priority:1"`
+	Username string `json:"username" gorm:"type:varchar(64)"`
+	Start    int32  `json:"start" gorm:"not null;uniqueIndex:idx_post_mentions_post_start,priority:2"`
+	End      int32  `json:"end" gorm:"not null"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func DomainPostMentionListToThrift(list []PostMention) []*thrift.MentionSpan {
+	res := make([]*thrift.MentionSpan, 0, len(list))
+	for _, m := range list {
+		res = append(res, &thrift.MentionSpan{
+			UserID:   m.UserID,
+			Username: m.Username,
+			Start:    m.Start,
+			End:      m.End,
+		})
+	}
+	return res
+}
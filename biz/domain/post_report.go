@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"time"
+	thrift "zetian-personal-website-hertz/biz/model/post"
+)
+
+// PostReport.Reason values
+const (
+	ReportReasonSpam           = "spam"
+	ReportReasonHarassment     = "harassment"
+	ReportReasonHate           = "hate"
+	ReportReasonSexual         = "sexual"
+	ReportReasonViolence       = "violence"
+	ReportReasonMisinformation = "misinformation"
+	ReportReasonOther          = "other"
+
+	// system report filed when the content filter holds a post for review (ReporterID = 0),
+	// users can't pick it
+	ReportReasonContentFilter = "content_filter"
+)
+
+// IsValidReportReason reports whether r is one of ReportReason*.
+func IsValidReportReason(r string) bool {
+	switch r {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonHate, ReportReasonSexual,
+		ReportReasonViolence, ReportReasonMisinformation, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+// PostReport.Status values
+const (
+	ReportStatusPending   = "pending"
+	ReportStatusDismissed = "dismissed" // 版主认为没问题
+	ReportStatusActioned  = "actioned"  // 版主处理了帖子（隐藏 / 删除 / 警告作者）
+)
+
+// PostReport — a user flagging a post. One report per (post, reporter).
+type PostReport struct {
+	ID         int64  `json:"id" gorm:"primaryKey;autoIncrement"`
+	PostID     int64  `json:"post_id" gorm:"not null;uniqueIndex:idx_post_reports_post_reporter,priority:1;index:idx_post_reports_status_post,priority:2"`
+	ReporterID int64  `json:"reporter_id" gorm:"not null;uniqueIndex:idx_post_reports_post_reporter,priority:2"`
+	Reason     string `json:"reason" gorm:"type:varchar(32);not null"`
+	Detail     string `json:"detail" gorm:"type:text"`
+
+	Status     string     `json:"status" gorm:"type:varchar(16);not null;default:'pending';index:idx_post_reports_status_post,priority:1"`
+	ResolvedBy *int64     `json:"resolved_by"`
+	ResolvedAt *time.Time `json:"resolved_at"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// ModerationAction.Action values
+const (
+	ModerationDismiss  = "dismiss"
+	ModerationHide     = "hide"
+	ModerationDelete   = "delete"
+	ModerationWarn     = "warn"
+	ModerationAutoHide = "auto_hide" // 举报数达到阈值，系统自动隐藏（ModeratorID = 0）
+	ModerationHold     = "hold"      // 内容过滤要求人工审核，系统隐藏（ModeratorID = 0）
+)
+
+// ModerationAction — audit trail of everything done to reported posts. Rows are never updated or deleted.
+type ModerationAction struct {
+	ID           int64  `json:"id" gorm:"primaryKey;autoIncrement"`
+	ModeratorID  int64  `json:"moderator_id" gorm:"not null"` // 0 = system
+	PostID       int64  `json:"post_id" gorm:"not null;index"`
+	TargetUserID int64  `json:"target_user_id" gorm:"not null;index"` // author of the post
+	Action       string `json:"action" gorm:"type:varchar(16);not null"`
+	Note         string `json:"note" gorm:"type:text"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// ReportedPost is a post in the moderation queue (it has pending reports).
+type ReportedPost struct {
+	Base            PostBase // loaded unscoped, Base.DeletedAt is set if the post is in the trash
+	PendingReports  int32
+	Reasons         map[string]int32
+	FirstReportedAt time.Time
+}
+
+func DomainReportedPostToThrift(r ReportedPost) *thrift.ReportedPost {
+	return &thrift.ReportedPost{
+		PostID:          r.Base.ID,
+		AuthorID:        r.Base.UserID,
+		Title:           r.Base.Title,
+		Content:         r.Base.Content,
+		Hidden:          r.Base.Hidden,
+		Deleted:         r.Base.DeletedAt.Valid,
+		PendingReports:  r.PendingReports,
+		Reasons:         r.Reasons,
+		FirstReportedAt: r.FirstReportedAt.Format(time.RFC3339),
+	}
+}
+
+func DomainModerationActionListToThrift(list []ModerationAction) []*thrift.ModerationAction {
+	res := make([]*thrift.ModerationAction, 0, len(list))
+	for _, a := range list {
+		res = append(res, &thrift.ModerationAction{
+			ID:           a.ID,
+			ModeratorID:  a.ModeratorID,
+			PostID:       a.PostID,
+			TargetUserID: a.TargetUserID,
+			Action:       a.Action,
+			Note:         a.Note,
+			CreatedAt:    a.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return res
+}
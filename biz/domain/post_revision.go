@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+	thrift "zetian-personal-website-hertz/biz/model/post"
+)
+
+// PostRevision — an earlier version of a post, saved by EditPost right before it is overwritten.
+//   - Version 0 is the original post, Version n is the content after the n-th edit.
+//   - CreatedAt is when this version was replaced (= time of the edit).
+type PostRevision struct {
+	ID      int64 `json:"id" gorm:"primaryKey;autoIncrement"`
+	PostID  int64 `json:"post_id" gorm:"not null;uniqueIndex:idx_post_revisions_post_version,priority:1"`
+	Version int32 `json:"version" gorm:"not null;uniqueIndex:idx_post_revisions_post_version,priority:2"`
+
+	Title   string `json:"title" gorm:"type:varchar(255)"`
+	Content string `json:"content" gorm:"type:text"`
+	Tags    string `json:"tags" gorm:"type:text"` // 同 PostBase.Tags，JSON 字符串
+
+	EditorID  int64     `json:"editor_id"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+func DomainPostRevisionToThrift(r PostRevision) thrift.PostRevision {
+	var tags []string
+	_ = json.Unmarshal([]byte(r.Tags), &tags)
+
+	return thrift.PostRevision{
+		ID:         r.ID,
+		PostID:     r.PostID,
+		Version:    r.Version,
+		Title:      r.Title,
+		Content:    r.Content,
+		Tags:       tags,
+		ReplacedAt: r.CreatedAt.Format(time.RFC3339Nano),
+	}
+}
+
+func DomainPostRevisionListToThriftPointers(list []PostRevision) []*thrift.PostRevision {
+	res := make([]*thrift.PostRevision, 0, len(list))
+	for _, r := range list {
+		tr := DomainPostRevisionToThrift(r)
+		res = append(res, &tr)
+	}
+	return res
+}
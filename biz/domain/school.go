@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 	"github.com/lib/pq" //let []string succesfully cast to textp[]
 )
@@ -10,7 +11,33 @@ type School struct {
 	Name        string    `json:"name" gorm:"type:varchar(128);not null"`
 	ShortName   string    `json:"short_name" gorm:"type:varchar(32);not null"`
 	Aliases pq.StringArray `json:"aliases" gorm:"type:text[]"`
+	// 学校邮箱域名（比如 "bu.edu"，子域名也算）：配置后只有注册邮箱属于这些域名的用户才能选这个学校；为空时谁都可以选
+	EmailDomains pq.StringArray `json:"email_domains" gorm:"type:text[]"`
 	Description string    `json:"description"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
+
+// AcceptsMember reports whether a user with this (verified) email may pick the school.
+// Schools whose EmailDomains are not filled in yet accept everybody, as before domains existed;
+// once they are set, only MatchesEmail addresses are accepted.
+func (s *School) AcceptsMember(email string) bool {
+	return len(s.EmailDomains) == 0 || s.MatchesEmail(email)
+}
+
+// MatchesEmail reports whether email is an address of this school (its domain is one of
+// EmailDomains or a subdomain of one). A school without EmailDomains matches nobody.
+func (s *School) MatchesEmail(email string) bool {
+	at := strings.LastIndexByte(email, '@')
+	if at < 0 {
+		return false
+	}
+	host := strings.ToLower(strings.TrimSpace(email[at+1:]))
+	for _, d := range s.EmailDomains {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if d != "" && (host == d || strings.HasSuffix(host, "."+d)) {
+			return true
+		}
+	}
+	return false
+}
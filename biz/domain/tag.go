@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+	thrift "zetian-personal-website-hertz/biz/model/tag"
+)
+
+// Tag — one normalized hashtag (lower case, without leading '#').
+type Tag struct {
+	ID        int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	Name      string    `json:"name" gorm:"type:varchar(64);not null;uniqueIndex"`
+	CreatedAt time.Time `json:"created_at" gorm:"autoCreateTime"`
+}
+
+// PostTag — join table between post_bases and tags.
+//
+// SchoolID / CreatedAt are copied from the post so that
+// "posts of tag X" and "trending tags of school Y" can be answered
+// from this table alone.
+type PostTag struct {
+	PostID    int64     `json:"post_id" gorm:"primaryKey"`
+	TagID     int64     `json:"tag_id" gorm:"primaryKey;index:idx_post_tags_tag_created,priority:1"`
+	SchoolID  int64     `json:"school_id" gorm:"not null;index:idx_post_tags_school_created,priority:1"`
+	CreatedAt time.Time `json:"created_at" gorm:"index:idx_post_tags_tag_created,priority:2;index:idx_post_tags_school_created,priority:2"`
+}
+
+// TrendingTag — usage of a tag in the current window vs the previous window of same length.
+type TrendingTag struct {
+	TagID     int64  `json:"tag_id"`
+	Name      string `json:"name"`
+	PostCount int64  `json:"post_count"` // current window
+	PrevCount int64  `json:"prev_count"` // previous window
+}
+
+// Growth is how many more posts used the tag compared with the previous window.
+func (t TrendingTag) Growth() int64 {
+	return t.PostCount - t.PrevCount
+}
+
+func DomainTrendingTagToThrift(t TrendingTag) thrift.TrendingTag {
+	return thrift.TrendingTag{
+		ID:        t.TagID,
+		Name:      t.Name,
+		PostCount: t.PostCount,
+		PrevCount: t.PrevCount,
+		Growth:    t.Growth(),
+	}
+}
+
+func DomainTrendingTagListToThriftPointers(list []TrendingTag) []*thrift.TrendingTag {
+	res := make([]*thrift.TrendingTag, 0, len(list))
+	for _, t := range list {
+		tt := DomainTrendingTagToThrift(t)
+		res = append(res, &tt)
+	}
+	return res
+}
@@ -12,6 +12,8 @@ type User struct {
 	Password string `gorm:"not null"`
     Email    string `gorm:"uniqueIndex;size:255"`
 	AvatarUrl string `gorm:"type:text"`
+	SchoolID int64 `gorm:"not null;default:0"` // 用户自己选的学校，0 = 未设置；用于 school-only 帖子，学校配置了邮箱域名时必须和注册邮箱对得上（School.AcceptsMember）
+	ShowLikedPosts bool `gorm:"not null;default:false"` // 别人能否看到 TA 点赞过的帖子（/post/liked），默认不公开
 }
 //note : gorm note only effect autoMigrate, it is not used to validate input
 
@@ -0,0 +1,272 @@
+// Code generated by hertz generator.
+
+package base
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	comment "zetian-personal-website-hertz/biz/model/comment"
+	"zetian-personal-website-hertz/biz/service/auth_service"
+	"zetian-personal-website-hertz/biz/service/comment_service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+	"gorm.io/gorm"
+)
+
+// CreateComment .
+// @router /comment/create [POST]
+func CreateComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.CreateCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// must be logged in
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, comment.CreateCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	if req.GetPostID() <= 0 {
+		c.JSON(consts.StatusBadRequest, comment.CreateCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: "invalid post id",
+		})
+		return
+	}
+
+	created, err := comment_service.CreateComment(
+		ctx,
+		userID,
+		req.GetPostID(),
+		req.GetContent(),
+		req.GetParentID(),
+	)
+	if err != nil {
+		status := consts.StatusBadRequest
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = consts.StatusNotFound
+		}
+		c.JSON(status, comment.CreateCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	thriftComment := domain.DomainCommentToThrift(*created)
+	c.JSON(consts.StatusOK, comment.CreateCommentResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Comment:      &thriftComment,
+	})
+}
+
+// DeleteComment .
+// @router /comment/delete [POST]
+func DeleteComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.DeleteCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, comment.DeleteCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	err = comment_service.DeleteComment(ctx, userID, req.GetID())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(consts.StatusNotFound, comment.DeleteCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: "Comment not found.",
+		})
+		return
+	}
+	if errors.Is(err, comment_service.ErrNoPermission) {
+		c.JSON(consts.StatusForbidden, comment.DeleteCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, comment.DeleteCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, comment.DeleteCommentResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+	})
+}
+
+// GetPostComments .
+// @router /comment/list [GET]
+func GetPostComments(ctx context.Context, c *app.RequestContext) {
+	var req comment.GetPostCommentsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// viewer 可选：有 JWT 就用（is_liked_by_user），没有就当 guest
+	var viewerID int64 = 0
+	if jwtBytes := c.Cookie("JWT"); len(jwtBytes) > 0 {
+		_, _, _, exp, uid, err := auth_service.ParseUserJWT(ctx, string(jwtBytes))
+		if err == nil && exp >= time.Now().Unix() {
+			viewerID = uid
+		}
+	}
+
+	result, err := comment_service.GetPostComments(ctx, viewerID, req.GetPostID(), req.GetCursor(), req.GetLimit())
+	if err != nil {
+		status := consts.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = consts.StatusNotFound
+		}
+		c.JSON(status, comment.GetPostCommentsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, comment.GetPostCommentsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Comments:     domain.DomainCommentListToThriftPointers(result.Comments),
+		NextCursor:   result.NextCursor,
+		HasMore:      result.HasMore,
+	})
+}
+
+// GetCommentReplies .
+// @router /comment/replies [GET]
+func GetCommentReplies(ctx context.Context, c *app.RequestContext) {
+	var req comment.GetCommentRepliesReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	var viewerID int64 = 0
+	if jwtBytes := c.Cookie("JWT"); len(jwtBytes) > 0 {
+		_, _, _, exp, uid, err := auth_service.ParseUserJWT(ctx, string(jwtBytes))
+		if err == nil && exp >= time.Now().Unix() {
+			viewerID = uid
+		}
+	}
+
+	result, err := comment_service.GetCommentReplies(ctx, viewerID, req.GetCommentID(), req.GetCursor(), req.GetLimit())
+	if err != nil {
+		status := consts.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = consts.StatusNotFound
+		}
+		c.JSON(status, comment.GetCommentRepliesResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, comment.GetCommentRepliesResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Comments:     domain.DomainCommentListToThriftPointers(result.Comments),
+		NextCursor:   result.NextCursor,
+		HasMore:      result.HasMore,
+	})
+}
+
+// LikeComment .
+// @router /comment/like [POST]
+func LikeComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.LikeCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, comment.UserFlagCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp <= time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, comment.UserFlagCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: "Unauthorized",
+		})
+		return
+	}
+
+	if err := comment_service.LikeComment(ctx, userID, req.GetCommentID()); err != nil {
+		c.JSON(consts.StatusOK, comment.UserFlagCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, comment.UserFlagCommentResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+	})
+}
+
+// UnlikeComment .
+// @router /comment/unlike [POST]
+func UnlikeComment(ctx context.Context, c *app.RequestContext) {
+	var req comment.UnlikeCommentReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, comment.UserFlagCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: "Invalid request: " + err.Error(),
+		})
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp <= time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, comment.UserFlagCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: "Unauthorized",
+		})
+		return
+	}
+
+	if err := comment_service.UnlikeComment(ctx, userID, req.GetCommentID()); err != nil {
+		c.JSON(consts.StatusOK, comment.UserFlagCommentResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, comment.UserFlagCommentResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+	})
+}
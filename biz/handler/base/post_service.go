@@ -10,6 +10,8 @@ import (
 
 	"zetian-personal-website-hertz/biz/domain"
 	post "zetian-personal-website-hertz/biz/model/post"
+	user "zetian-personal-website-hertz/biz/model/user"
+	"zetian-personal-website-hertz/biz/pkg/cursor"
 	"zetian-personal-website-hertz/biz/service/auth_service"
 	"zetian-personal-website-hertz/biz/service/picture_upload_service"
 	"zetian-personal-website-hertz/biz/service/post_service"
@@ -46,10 +48,10 @@ func GetPostByID(ctx context.Context, c *app.RequestContext) {
 	}
 	// 若 JWT 不存在 / 解析失败 / 过期，则 viewerID 保持 -1，
 	// 对应 service 中 IsLikedByUser / IsFavByUser 会默认 false，
-	// 同时 view_count 不会自增。
+	// 浏览量按客户端 IP 去重计数。
 
 	// 2) get post（内部会按规则自增 view_count）
-	domainPostFull, err := post_service.GetPost(ctx, req.ID, viewerID)
+	domainPostFull, err := post_service.GetPost(ctx, req.ID, viewerID, c.ClientIP())
 	if err != nil {
 		status := consts.StatusBadRequest
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -107,6 +109,16 @@ func CreatePost(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
+	var pollInput *post_service.PollInput
+	if p := req.GetPoll(); p != nil {
+		pollInput = &post_service.PollInput{
+			Question:    p.GetQuestion(),
+			Options:     p.GetOptions(),
+			MultiChoice: p.GetMultiChoice(),
+			ClosesAt:    p.GetClosesAt(),
+		}
+	}
+
 	domainPost, err := post_service.CreatePost(
 		ctx,
 		req.GetUserID(),
@@ -119,7 +131,19 @@ func CreatePost(ctx context.Context, c *app.RequestContext) {
 		req.Location,       // *string
 		req.GetTags(),      // []string
 		req.ReplyTo,        // *int64
+		req.GetVisibility(),
+		pollInput,
+		req.Latitude,
+		req.Longitude,
 	)
+	if errors.Is(err, post_service.ErrInvalidVisibility) || errors.Is(err, post_service.ErrInvalidPoll) ||
+		errors.Is(err, post_service.ErrInvalidLocation) || errors.Is(err, post_service.ErrContentRejected) {
+		c.JSON(consts.StatusBadRequest, post.CreatePostResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(consts.StatusInternalServerError, post.CreatePostResp{
 			IsSuccessful: false,
@@ -180,12 +204,16 @@ func EditPost(ctx context.Context, c *app.RequestContext) {
 		req.GetID(),
 		req.GetTitle(),
 		req.GetContent(),
+		req.Tags, // nil => tags unchanged
 	)
 	if err != nil {
 		status := consts.StatusInternalServerError
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			status = consts.StatusNotFound
 		}
+		if errors.Is(err, post_service.ErrContentRejected) {
+			status = consts.StatusBadRequest
+		}
 		c.JSON(status, post.EditPostResp{
 			IsSuccessful: false,
 			ErrorMessage: err.Error(),
@@ -227,16 +255,7 @@ func DeletePost(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 
-	postToBeDelete, err := post_service.GetPostBase(ctx, req.GetID())
-	if err != nil {
-		c.JSON(consts.StatusInternalServerError, post.DeletePostResp{
-			IsSuccessful: false,
-			ErrorMessage: "Failed to fetch post before deletion: " + err.Error(),
-		})
-		return
-	}
-	picture_upload_service.DeletePostImagesJSON(ctx, postToBeDelete.MediaUrls)
-
+	// 只是移进回收站；media 等到 purger 真正删除时再清理
 	err = post_service.DeletePost(ctx, userID, req.GetID())
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		c.JSON(consts.StatusNotFound, post.DeletePostResp{
@@ -280,13 +299,21 @@ func GetSchoolRecentPosts(ctx context.Context, c *app.RequestContext) {
 		viewerID = id
 	}
 
-	posts, postIDToQuotedPosts, err := post_service.GetSchoolRecentPosts(
+	page, err := post_service.GetSchoolRecentPosts(
 		ctx,
 		req.SchoolID,
 		viewerID,
+		req.Cursor,
 		req.Before,
 		int(req.Limit),
 	)
+	if errors.Is(err, cursor.ErrInvalidCursor) || errors.Is(err, post_service.ErrInvalidBefore) {
+		c.JSON(consts.StatusBadRequest, post.GetSchoolRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(consts.StatusInternalServerError, post.GetSchoolRecentPostsResp{
 			IsSuccessful: false,
@@ -299,8 +326,10 @@ func GetSchoolRecentPosts(ctx context.Context, c *app.RequestContext) {
 	resp := post.GetSchoolRecentPostsResp{
 		IsSuccessful: true,
 		ErrorMessage: "",
-		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(postIDToQuotedPosts),
-		Posts:        domain.DomainPostListToThriftPointers(posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
 	}
 
 	c.JSON(consts.StatusOK, resp)
@@ -332,13 +361,21 @@ func GetPersonalRecentPosts(ctx context.Context, c *app.RequestContext) {
 		viewerID = id
 	}
 
-	posts, postIDToQuotedPosts, err := post_service.GetPersonalRecentPosts(
+	page, err := post_service.GetPersonalRecentPosts(
 		ctx,
 		req.UserID,
 		viewerID,
+		req.Cursor,
 		req.Before,
 		int(req.Limit),
 	)
+	if errors.Is(err, cursor.ErrInvalidCursor) || errors.Is(err, post_service.ErrInvalidBefore) {
+		c.JSON(consts.StatusBadRequest, post.GetPersonalRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(consts.StatusInternalServerError, post.GetPersonalRecentPostsResp{
 			IsSuccessful: false,
@@ -351,8 +388,10 @@ func GetPersonalRecentPosts(ctx context.Context, c *app.RequestContext) {
 	resp := post.GetPersonalRecentPostsResp{
 		IsSuccessful: true,
 		ErrorMessage: "",
-		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(postIDToQuotedPosts),
-		Posts:        domain.DomainPostListToThriftPointers(posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
 	}
 
 	c.JSON(consts.StatusOK, resp)
@@ -545,3 +584,1410 @@ func UploadPostMedia(ctx context.Context, c *app.RequestContext) {
 		Urls: urls,
 	})
 }
+
+// GetFollowingUsersRecentPosts .
+// @router /post/following/recent [GET]
+func GetFollowingUsersRecentPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetFollowingUsersRecentPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.JSON(consts.StatusBadRequest, post.GetFollowingUsersRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Invalid request: " + err.Error(),
+			Posts:        nil,
+		})
+		return
+	}
+
+	if req.Limit == 0 {
+		req.Limit = 10 // default limit
+	}
+
+	// following feed 必须登录：只信 JWT 的 userID，忽略 req.UserID
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, viewerID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp <= time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.GetFollowingUsersRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+			Posts:        nil,
+		})
+		return
+	}
+
+	page, err := post_service.GetFollowingUsersRecentPosts(
+		ctx,
+		viewerID,
+		req.Cursor,
+		req.Before,
+		int(req.Limit),
+	)
+	if errors.Is(err, cursor.ErrInvalidCursor) || errors.Is(err, post_service.ErrInvalidBefore) {
+		c.JSON(consts.StatusBadRequest, post.GetFollowingUsersRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetFollowingUsersRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch posts: " + err.Error(),
+			Posts:        nil,
+		})
+		return
+	}
+
+	resp := post.GetFollowingUsersRecentPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	}
+
+	c.JSON(consts.StatusOK, resp)
+}
+
+// GetSchoolHotPosts .
+// @router /post/school/hot [GET]
+func GetSchoolHotPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetSchoolHotPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.SchoolID <= 0 {
+		c.JSON(consts.StatusBadRequest, post.GetSchoolHotPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "invalid school id",
+		})
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// viewer（用于 is_liked_by_user / is_fav_by_user）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	page, err := post_service.GetHotPosts(ctx, req.SchoolID, viewerID, req.Cursor, int(req.Limit))
+	if errors.Is(err, cursor.ErrInvalidCursor) {
+		c.JSON(consts.StatusBadRequest, post.GetSchoolHotPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetSchoolHotPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch posts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetSchoolHotPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetHotPosts .
+// @router /post/hot [GET]
+func GetHotPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetHotPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	// schoolID = 0: across all schools
+	page, err := post_service.GetHotPosts(ctx, 0, viewerID, req.Cursor, int(req.Limit))
+	if errors.Is(err, cursor.ErrInvalidCursor) {
+		c.JSON(consts.StatusBadRequest, post.GetHotPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetHotPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch posts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetHotPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// SearchPosts .
+// @router /post/search [GET]
+func SearchPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.SearchPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	params := post_service.SearchPostsParams{
+		Query:      req.Query,
+		SchoolID:   req.SchoolID,
+		CategoryID: req.CategoryID,
+		UserID:     req.UserID,
+		Tag:        req.Tag,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+	}
+
+	page, err := post_service.SearchPosts(ctx, params, viewerID, req.Cursor, int(req.Limit))
+	if errors.Is(err, post_service.ErrInvalidSearch) || errors.Is(err, cursor.ErrInvalidCursor) {
+		c.JSON(consts.StatusBadRequest, post.SearchPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.SearchPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to search posts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.SearchPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetCategoryRecentPosts .
+// @router /post/category/recent [GET]
+func GetCategoryRecentPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetCategoryRecentPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.SchoolID <= 0 {
+		c.JSON(consts.StatusBadRequest, post.GetCategoryRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "invalid school id",
+		})
+		return
+	}
+	categoryID, err := post_service.ResolveCategoryID(req.CategoryID, req.CategoryKey)
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, post.GetCategoryRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// viewer（用于 is_liked_by_user / is_fav_by_user）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	page, err := post_service.GetCategoryRecentPosts(
+		ctx,
+		req.SchoolID,
+		categoryID,
+		viewerID,
+		req.Cursor,
+		req.Before,
+		int(req.Limit),
+	)
+	if errors.Is(err, cursor.ErrInvalidCursor) || errors.Is(err, post_service.ErrInvalidBefore) {
+		c.JSON(consts.StatusBadRequest, post.GetCategoryRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetCategoryRecentPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch posts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetCategoryRecentPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetPostRevisions .
+// @router /post/revisions [GET]
+func GetPostRevisions(ctx context.Context, c *app.RequestContext) {
+	var req post.GetPostRevisionsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.ID <= 0 {
+		c.JSON(consts.StatusBadRequest, post.GetPostRevisionsResp{
+			IsSuccessful: false,
+			ErrorMessage: "ID cannot be null",
+		})
+		return
+	}
+
+	// viewer（草稿 / 隐藏 / 非公开帖子的历史版本只给能看到帖子的人）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	revs, err := post_service.GetPostRevisions(ctx, req.ID, viewerID)
+	if err != nil {
+		status := consts.StatusInternalServerError
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			status = consts.StatusNotFound
+		}
+		c.JSON(status, post.GetPostRevisionsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetPostRevisionsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Revisions:    domain.DomainPostRevisionListToThriftPointers(revs),
+	})
+}
+
+// GetTrashedPosts .
+// @router /post/trash [GET]
+func GetTrashedPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetTrashedPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.GetTrashedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	trashed, err := post_service.GetTrashedPosts(ctx, userID, int(req.Limit))
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetTrashedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch trash: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetTrashedPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainTrashedPostListToThriftPointers(trashed),
+	})
+}
+
+// RestorePost .
+// @router /post/restore [POST]
+func RestorePost(ctx context.Context, c *app.RequestContext) {
+	var req post.RestorePostReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.RestorePostResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	restored, err := post_service.RestorePost(ctx, userID, req.GetID())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(consts.StatusNotFound, post.RestorePostResp{
+			IsSuccessful: false,
+			ErrorMessage: "Post not found in your trash.",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.RestorePostResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	thriftPost := domain.DomainPostToThrift(*restored)
+	c.JSON(consts.StatusOK, post.RestorePostResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Post:         &thriftPost,
+	})
+}
+
+// SaveDraft .
+// @router /post/draft/save [POST]
+func SaveDraft(ctx context.Context, c *app.RequestContext) {
+	var req post.SaveDraftReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.SaveDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	// 草稿可以是半成品，title / content 允许为空；定时发布在 service 里要求完整
+	draft, err := post_service.SaveDraft(ctx, userID, post_service.DraftInput{
+		SchoolID:   req.GetSchoolID(),
+		CategoryID: req.GetCategoryID(),
+		Title:      req.GetTitle(),
+		Content:    req.GetContent(),
+		MediaType:  req.GetMediaType(),
+		MediaUrls:  req.GetMediaUrls(),
+		Location:   req.Location,
+		Tags:       req.GetTags(),
+		ReplyTo:    req.ReplyTo,
+		PublishAt:  req.GetPublishAt(),
+		Visibility: req.GetVisibility(),
+	})
+	if errors.Is(err, post_service.ErrInvalidPublishAt) || errors.Is(err, post_service.ErrDraftIncomplete) ||
+		errors.Is(err, post_service.ErrInvalidVisibility) || errors.Is(err, post_service.ErrContentRejected) {
+		c.JSON(consts.StatusBadRequest, post.SaveDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.SaveDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	thriftPost := domain.DomainPostToThrift(*draft)
+	c.JSON(consts.StatusOK, post.SaveDraftResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Post:         &thriftPost,
+	})
+}
+
+// UpdateDraft .
+// @router /post/draft/update [POST]
+func UpdateDraft(ctx context.Context, c *app.RequestContext) {
+	var req post.UpdateDraftReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.UpdateDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	draft, err := post_service.UpdateDraft(ctx, userID, req.GetID(), post_service.DraftInput{
+		SchoolID:   req.GetSchoolID(),
+		CategoryID: req.GetCategoryID(),
+		Title:      req.GetTitle(),
+		Content:    req.GetContent(),
+		MediaType:  req.GetMediaType(),
+		MediaUrls:  req.GetMediaUrls(),
+		Location:   req.Location,
+		Tags:       req.GetTags(),
+		ReplyTo:    req.ReplyTo,
+		PublishAt:  req.GetPublishAt(),
+		Visibility: req.GetVisibility(),
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(consts.StatusNotFound, post.UpdateDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: "Draft not found.",
+		})
+		return
+	}
+	if errors.Is(err, post_service.ErrInvalidPublishAt) || errors.Is(err, post_service.ErrDraftIncomplete) ||
+		errors.Is(err, post_service.ErrInvalidVisibility) || errors.Is(err, post_service.ErrContentRejected) {
+		c.JSON(consts.StatusBadRequest, post.UpdateDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.UpdateDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	thriftPost := domain.DomainPostToThrift(*draft)
+	c.JSON(consts.StatusOK, post.UpdateDraftResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Post:         &thriftPost,
+	})
+}
+
+// PublishDraft .
+// @router /post/draft/publish [POST]
+func PublishDraft(ctx context.Context, c *app.RequestContext) {
+	var req post.PublishDraftReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.PublishDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	published, err := post_service.PublishDraft(ctx, userID, req.GetID())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(consts.StatusNotFound, post.PublishDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: "Draft not found.",
+		})
+		return
+	}
+	if errors.Is(err, post_service.ErrDraftIncomplete) {
+		c.JSON(consts.StatusBadRequest, post.PublishDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.PublishDraftResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	thriftPost := domain.DomainPostToThrift(*published)
+	c.JSON(consts.StatusOK, post.PublishDraftResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Post:         &thriftPost,
+	})
+}
+
+// GetMyDrafts .
+// @router /post/drafts [GET]
+func GetMyDrafts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetMyDraftsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.GetMyDraftsResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	drafts, err := post_service.GetMyDrafts(ctx, userID, int(req.Limit))
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetMyDraftsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch drafts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetMyDraftsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(drafts),
+	})
+}
+
+// VotePoll .
+// @router /post/poll/vote [POST]
+func VotePoll(ctx context.Context, c *app.RequestContext) {
+	var req post.VotePollReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.VotePollResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	poll, err := post_service.VotePoll(ctx, userID, req.GetPostID(), req.GetOptionIds())
+	if err != nil {
+		status := consts.StatusInternalServerError
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			status = consts.StatusNotFound
+		case errors.Is(err, post_service.ErrAlreadyVoted):
+			status = consts.StatusConflict
+		case errors.Is(err, post_service.ErrPollClosed), errors.Is(err, post_service.ErrInvalidVote):
+			status = consts.StatusBadRequest
+		}
+		c.JSON(status, post.VotePollResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.VotePollResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Poll:         domain.DomainPollViewToThrift(*poll),
+	})
+}
+
+// GetNearbyPosts .
+// @router /post/nearby [GET]
+func GetNearbyPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetNearbyPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Lat == nil || req.Lng == nil {
+		c.JSON(consts.StatusBadRequest, post.GetNearbyPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "lat and lng are required",
+		})
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	// viewer（用于 is_liked_by_user / is_fav_by_user）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	page, err := post_service.GetNearbyPosts(
+		ctx,
+		req.GetLat(),
+		req.GetLng(),
+		int(req.Radius),
+		viewerID,
+		req.Cursor,
+		int(req.Limit),
+	)
+	if errors.Is(err, post_service.ErrInvalidLocation) || errors.Is(err, cursor.ErrInvalidCursor) {
+		c.JSON(consts.StatusBadRequest, post.GetNearbyPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetNearbyPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch posts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetNearbyPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetMentionedPosts .
+// @router /post/mentions [GET]
+func GetMentionedPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetMentionedPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.GetMentionedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	page, err := post_service.GetMentionedPosts(ctx, userID, req.Cursor, int(req.Limit))
+	if errors.Is(err, cursor.ErrInvalidCursor) {
+		c.JSON(consts.StatusBadRequest, post.GetMentionedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetMentionedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch posts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetMentionedPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetPostQuotes .
+// @router /post/quotes [GET]
+func GetPostQuotes(ctx context.Context, c *app.RequestContext) {
+	var req post.GetPostQuotesReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.ID == 0 {
+		c.JSON(consts.StatusBadRequest, post.GetPostQuotesResp{
+			IsSuccessful: false,
+			ErrorMessage: "ID cannot be null",
+		})
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	// viewer（用于 is_liked_by_user / is_fav_by_user 和可见性）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	page, err := post_service.GetPostQuotes(ctx, req.ID, viewerID, req.Cursor, int(req.Limit))
+	if err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to fetch posts: " + err.Error()
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			status, msg = consts.StatusNotFound, "post not found"
+		case errors.Is(err, cursor.ErrInvalidCursor):
+			status, msg = consts.StatusBadRequest, err.Error()
+		}
+		c.JSON(status, post.GetPostQuotesResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetPostQuotesResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetFavoritedPosts .
+// @router /post/favorites [GET]
+func GetFavoritedPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetFavoritedPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, post.GetFavoritedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login first",
+		})
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	page, err := post_service.GetFavoritedPosts(ctx, userID, req.Cursor, int(req.Limit))
+	if errors.Is(err, cursor.ErrInvalidCursor) {
+		c.JSON(consts.StatusBadRequest, post.GetFavoritedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, post.GetFavoritedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch posts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetFavoritedPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetLikedPosts .
+// @router /post/liked [GET]
+func GetLikedPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.GetLikedPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// viewer（用于隐私判断和 is_liked_by_user / is_fav_by_user）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	ownerID := req.UserID
+	if ownerID == 0 {
+		if viewerID <= 0 {
+			c.JSON(consts.StatusUnauthorized, post.GetLikedPostsResp{
+				IsSuccessful: false,
+				ErrorMessage: "unauthorized, please login first",
+			})
+			return
+		}
+		ownerID = viewerID
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	page, err := post_service.GetLikedPosts(ctx, ownerID, viewerID, req.Cursor, int(req.Limit))
+	if err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to fetch posts: " + err.Error()
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			status, msg = consts.StatusNotFound, "user not found"
+		case errors.Is(err, post_service.ErrLikedPostsHidden):
+			status, msg = consts.StatusForbidden, err.Error()
+		case errors.Is(err, cursor.ErrInvalidCursor):
+			status, msg = consts.StatusBadRequest, err.Error()
+		}
+		c.JSON(status, post.GetLikedPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetLikedPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetPostLikers .
+// @router /post/likers [GET]
+func GetPostLikers(ctx context.Context, c *app.RequestContext) {
+	var req post.GetPostLikersReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.PostID == 0 {
+		c.JSON(consts.StatusBadRequest, post.GetPostLikersResp{
+			IsSuccessful: false,
+			ErrorMessage: "post_id cannot be null",
+		})
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	// viewer（用于可见性和 isFollowing / followedYou）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	page, err := post_service.GetPostLikers(ctx, req.PostID, viewerID, req.Cursor, int(req.Limit))
+	if err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to fetch likers: " + err.Error()
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			status, msg = consts.StatusNotFound, "post not found"
+		case errors.Is(err, cursor.ErrInvalidCursor):
+			status, msg = consts.StatusBadRequest, err.Error()
+		}
+		c.JSON(status, post.GetPostLikersResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	users := make([]*user.SimpleUserProfile, 0, len(page.Users))
+	for _, u := range page.Users {
+		users = append(users, &user.SimpleUserProfile{
+			ID:          u.Id,
+			UserName:    u.UserName,
+			AvatarUrl:   u.AvatarUrl,
+			IsFollowing: u.IsFollowing,
+			FollowedYou: u.FollowedYou,
+			IsMe:        u.IsMe,
+		})
+	}
+
+	c.JSON(consts.StatusOK, post.GetPostLikersResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Users:        users,
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// BatchGetPosts .
+// @router /post/batch_get [POST]
+func BatchGetPosts(ctx context.Context, c *app.RequestContext) {
+	var req post.BatchGetPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	// viewer（用于可见性和 is_liked_by_user / is_fav_by_user）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	res, err := post_service.BatchGetPosts(ctx, req.Ids, viewerID)
+	if err != nil {
+		status := consts.StatusInternalServerError
+		if errors.Is(err, post_service.ErrTooManyPosts) {
+			status = consts.StatusBadRequest
+		}
+		c.JSON(status, post.BatchGetPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.BatchGetPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(res.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(res.QuotedPosts),
+		MissingIds:   res.MissingIDs,
+	})
+}
+
+// ReportPost .
+// @router /post/report [POST]
+func ReportPost(ctx context.Context, c *app.RequestContext) {
+	var req post.ReportPostReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.PostID == 0 {
+		c.JSON(consts.StatusBadRequest, post.ReportPostResp{
+			IsSuccessful: false,
+			ErrorMessage: "post_id cannot be null",
+		})
+		return
+	}
+
+	userID, ok := getViewerIDFromJWTOrWriteUnauthorized(ctx, c)
+	if !ok {
+		return
+	}
+
+	if err := post_service.ReportPost(ctx, userID, req.PostID, req.Reason, req.Detail); err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to report post: " + err.Error()
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			status, msg = consts.StatusNotFound, "post not found"
+		case errors.Is(err, post_service.ErrInvalidReportReason),
+			errors.Is(err, post_service.ErrCannotReportOwnPost):
+			status, msg = consts.StatusBadRequest, err.Error()
+		}
+		c.JSON(status, post.ReportPostResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.ReportPostResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+	})
+}
+
+// GetPendingReports .
+// @router /post/moderation/reports [GET]
+func GetPendingReports(ctx context.Context, c *app.RequestContext) {
+	var req post.GetPendingReportsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	userID, ok := getViewerIDFromJWTOrWriteUnauthorized(ctx, c)
+	if !ok {
+		return
+	}
+
+	page, err := post_service.GetPendingReports(ctx, userID, req.Cursor, int(req.Limit))
+	if err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to fetch reports: " + err.Error()
+		switch {
+		case errors.Is(err, post_service.ErrNotModerator):
+			status, msg = consts.StatusForbidden, err.Error()
+		case errors.Is(err, cursor.ErrInvalidCursor):
+			status, msg = consts.StatusBadRequest, err.Error()
+		}
+		c.JSON(status, post.GetPendingReportsResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	posts := make([]*post.ReportedPost, 0, len(page.Posts))
+	for _, p := range page.Posts {
+		posts = append(posts, domain.DomainReportedPostToThrift(p))
+	}
+
+	c.JSON(consts.StatusOK, post.GetPendingReportsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        posts,
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// ResolvePostReports .
+// @router /post/moderation/resolve [POST]
+func ResolvePostReports(ctx context.Context, c *app.RequestContext) {
+	var req post.ResolvePostReportsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.PostID == 0 {
+		c.JSON(consts.StatusBadRequest, post.ResolvePostReportsResp{
+			IsSuccessful: false,
+			ErrorMessage: "post_id cannot be null",
+		})
+		return
+	}
+
+	userID, ok := getViewerIDFromJWTOrWriteUnauthorized(ctx, c)
+	if !ok {
+		return
+	}
+
+	resolved, err := post_service.ResolvePostReports(ctx, userID, req.PostID, req.Action, req.Note)
+	if err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to resolve reports: " + err.Error()
+		switch {
+		case errors.Is(err, post_service.ErrNotModerator):
+			status, msg = consts.StatusForbidden, err.Error()
+		case errors.Is(err, post_service.ErrInvalidModeration):
+			status, msg = consts.StatusBadRequest, err.Error()
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			status, msg = consts.StatusNotFound, "post not found"
+		}
+		c.JSON(status, post.ResolvePostReportsResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.ResolvePostReportsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Resolved:     resolved,
+	})
+}
+
+// GetModerationActions .
+// @router /post/moderation/actions [GET]
+func GetModerationActions(ctx context.Context, c *app.RequestContext) {
+	var req post.GetModerationActionsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	userID, ok := getViewerIDFromJWTOrWriteUnauthorized(ctx, c)
+	if !ok {
+		return
+	}
+
+	page, err := post_service.GetModerationActions(ctx, userID, req.PostID, req.Cursor, int(req.Limit))
+	if err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to fetch moderation actions: " + err.Error()
+		switch {
+		case errors.Is(err, post_service.ErrNotModerator):
+			status, msg = consts.StatusForbidden, err.Error()
+		case errors.Is(err, cursor.ErrInvalidCursor):
+			status, msg = consts.StatusBadRequest, err.Error()
+		}
+		c.JSON(status, post.GetModerationActionsResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetModerationActionsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Actions:      domain.DomainModerationActionListToThrift(page.Actions),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// PinPost .
+// @router /post/pin [POST]
+func PinPost(ctx context.Context, c *app.RequestContext) {
+	var req post.PinPostReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.PostID == 0 {
+		c.JSON(consts.StatusBadRequest, post.PinPostResp{
+			IsSuccessful: false,
+			ErrorMessage: "post_id cannot be null",
+		})
+		return
+	}
+
+	userID, ok := getViewerIDFromJWTOrWriteUnauthorized(ctx, c)
+	if !ok {
+		return
+	}
+
+	if err := post_service.PinPost(ctx, userID, req.PostID); err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to pin post: " + err.Error()
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			status, msg = consts.StatusNotFound, "post not found"
+		case errors.Is(err, post_service.ErrNotPostOwner):
+			status, msg = consts.StatusForbidden, err.Error()
+		case errors.Is(err, post_service.ErrTooManyPinned):
+			status, msg = consts.StatusBadRequest, err.Error()
+		}
+		c.JSON(status, post.PinPostResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.PinPostResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+	})
+}
+
+// UnpinPost .
+// @router /post/unpin [POST]
+func UnpinPost(ctx context.Context, c *app.RequestContext) {
+	var req post.UnpinPostReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.PostID == 0 {
+		c.JSON(consts.StatusBadRequest, post.UnpinPostResp{
+			IsSuccessful: false,
+			ErrorMessage: "post_id cannot be null",
+		})
+		return
+	}
+
+	userID, ok := getViewerIDFromJWTOrWriteUnauthorized(ctx, c)
+	if !ok {
+		return
+	}
+
+	if err := post_service.UnpinPost(ctx, userID, req.PostID); err != nil {
+		status := consts.StatusInternalServerError
+		msg := "Failed to unpin post: " + err.Error()
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			status, msg = consts.StatusNotFound, "post not found"
+		case errors.Is(err, post_service.ErrNotPostOwner):
+			status, msg = consts.StatusForbidden, err.Error()
+		}
+		c.JSON(status, post.UnpinPostResp{
+			IsSuccessful: false,
+			ErrorMessage: msg,
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.UnpinPostResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+	})
+}
+
+// GetMyModerationActions .
+// @router /post/moderation/mine [GET]
+func GetMyModerationActions(ctx context.Context, c *app.RequestContext) {
+	var req post.GetMyModerationActionsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	userID, ok := getViewerIDFromJWTOrWriteUnauthorized(ctx, c)
+	if !ok {
+		return
+	}
+
+	page, err := post_service.GetMyModerationActions(ctx, userID, req.Cursor, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, cursor.ErrInvalidCursor) {
+			c.JSON(consts.StatusBadRequest, post.GetModerationActionsResp{
+				IsSuccessful: false,
+				ErrorMessage: err.Error(),
+			})
+			return
+		}
+		c.JSON(consts.StatusInternalServerError, post.GetModerationActionsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch moderation actions: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, post.GetModerationActionsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Actions:      domain.DomainModerationActionListToThrift(page.Actions),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
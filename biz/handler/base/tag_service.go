@@ -0,0 +1,116 @@
+// Code generated by hertz generator.
+
+package base
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	tag "zetian-personal-website-hertz/biz/model/tag"
+	"zetian-personal-website-hertz/biz/pkg/cursor"
+	"zetian-personal-website-hertz/biz/service/auth_service"
+	"zetian-personal-website-hertz/biz/service/post_service"
+	"zetian-personal-website-hertz/biz/service/tag_service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// GetTagPosts .
+// @router /tag/posts [GET]
+func GetTagPosts(ctx context.Context, c *app.RequestContext) {
+	var req tag.GetTagPostsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if tag_service.NormalizeTag(req.Name) == "" {
+		c.JSON(consts.StatusBadRequest, tag.GetTagPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "invalid tag name",
+		})
+		return
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	// viewer（用于 is_liked_by_user / is_fav_by_user）
+	viewerID := int64(-1)
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+	if err == nil && exp > time.Now().Unix() {
+		viewerID = id
+	}
+
+	page, err := post_service.GetTagRecentPosts(
+		ctx,
+		req.Name,
+		req.SchoolID,
+		viewerID,
+		req.Cursor,
+		req.Before,
+		int(req.Limit),
+	)
+	if errors.Is(err, cursor.ErrInvalidCursor) || errors.Is(err, post_service.ErrInvalidBefore) {
+		c.JSON(consts.StatusBadRequest, tag.GetTagPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, tag.GetTagPostsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch posts: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, tag.GetTagPostsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Posts:        domain.DomainPostListToThriftPointers(page.Posts),
+		QuotedPosts:  domain.DomainPostMapToThriftPointerMap(page.QuotedPosts),
+		NextCursor:   page.NextCursor,
+		HasMore:      page.HasMore,
+	})
+}
+
+// GetTrendingTags .
+// @router /tag/trending [GET]
+func GetTrendingTags(ctx context.Context, c *app.RequestContext) {
+	var req tag.GetTrendingTagsReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+	if req.Limit > 50 {
+		req.Limit = 50
+	}
+
+	// window_hours <= 0 => default window, too large => capped in service
+	window := time.Duration(req.WindowHours) * time.Hour
+
+	tags, err := tag_service.GetTrendingTags(ctx, req.SchoolID, window, int(req.Limit))
+	if err != nil {
+		c.JSON(consts.StatusInternalServerError, tag.GetTrendingTagsResp{
+			IsSuccessful: false,
+			ErrorMessage: "Failed to fetch trending tags: " + err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, tag.GetTrendingTagsResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		Tags:         domain.DomainTrendingTagListToThriftPointers(tags),
+	})
+}
@@ -3,6 +3,7 @@ package base
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/cloudwego/hertz/pkg/protocol/consts"
 
 	"zetian-personal-website-hertz/biz/config"
+	"zetian-personal-website-hertz/biz/middleware"
 	user "zetian-personal-website-hertz/biz/model/user"
 	authService "zetian-personal-website-hertz/biz/service/auth_service"
 	"zetian-personal-website-hertz/biz/service/picture_upload_service"
@@ -35,6 +37,10 @@ func Login(ctx context.Context, c *app.RequestContext) {
 		return
 	}
 	fmt.Println(req.GetEmail(), "password:", req.GetPassword())
+	// 按目标账号限流（中间件只按 IP），防止换 IP 撞同一个账号的密码
+	if !middleware.Allow(ctx, c, "/login/account", "account:"+strings.ToLower(strings.TrimSpace(req.GetEmail()))) {
+		return
+	}
 	// 验证用户名密码
 	domainUser, err := userService.Login(ctx, req.GetEmail(), req.GetPassword())
 	if err != nil {
@@ -627,3 +633,75 @@ func GetFollowers(ctx context.Context, c *app.RequestContext) {
 		HasMore:      result.HasMore,
 	})
 }
+
+// UpdateSchool .
+// @router /user/update-school [POST]
+func UpdateSchool(ctx context.Context, c *app.RequestContext) {
+	var req user.UpdateSchoolReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := authService.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, user.UpdateSchoolResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login again",
+		})
+		return
+	}
+
+	if err := userService.UpdateSchool(ctx, userID, req.GetSchoolId()); err != nil {
+		status := consts.StatusBadRequest
+		if errors.Is(err, userService.ErrSchoolNotVerified) {
+			status = consts.StatusForbidden
+		}
+		c.JSON(status, user.UpdateSchoolResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, user.UpdateSchoolResp{
+		IsSuccessful: true,
+		ErrorMessage: "",
+		SchoolId:     req.GetSchoolId(),
+	})
+}
+
+// UpdatePrivacy .
+// @router /user/update-privacy [POST]
+func UpdatePrivacy(ctx context.Context, c *app.RequestContext) {
+	var req user.UpdatePrivacyReq
+	if err := c.BindAndValidate(&req); err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	jwtStr := string(c.Cookie("JWT"))
+	_, _, _, exp, userID, err := authService.ParseUserJWT(ctx, jwtStr)
+	if err != nil || exp < time.Now().Unix() {
+		c.JSON(consts.StatusUnauthorized, user.UpdatePrivacyResp{
+			IsSuccessful: false,
+			ErrorMessage: "unauthorized, please login again",
+		})
+		return
+	}
+
+	if err := userService.UpdatePrivacy(ctx, userID, req.GetShowLikedPosts()); err != nil {
+		c.JSON(consts.StatusInternalServerError, user.UpdatePrivacyResp{
+			IsSuccessful: false,
+			ErrorMessage: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(consts.StatusOK, user.UpdatePrivacyResp{
+		IsSuccessful:   true,
+		ErrorMessage:   "",
+		ShowLikedPosts: req.GetShowLikedPosts(),
+	})
+}
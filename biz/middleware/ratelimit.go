@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"zetian-personal-website-hertz/biz/config"
+	"zetian-personal-website-hertz/biz/pkg/ratelimit"
+	"zetian-personal-website-hertz/biz/service/auth_service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/common/utils"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+/*
+Rate limiting
+-------------
+RateLimit(route) limits requests of one route (config rate_limits[route]) per caller:
+  - logged-in callers (valid JWT cookie) by user id, so a user is limited on every device at once;
+  - anonymous callers by client IP (see ClientIPFunc: X-Forwarded-For / X-Real-IP are only
+    believed when the peer is one of config trusted_proxies, otherwise the socket address is used).
+Refused requests get 429 with Retry-After (seconds). Routes without a rule are not limited.
+*/
+
+// limiterStore holds the buckets of every route. In memory for now (single server);
+// set it to a shared ratelimit.Store before the server starts to share limits across instances.
+var limiterStore ratelimit.Store = ratelimit.NewMemoryStore()
+
+// SetRateLimitStore replaces the store used by RateLimit. Call before the server starts.
+func SetRateLimitStore(s ratelimit.Store) {
+	limiterStore = s
+}
+
+// RateLimit returns the middleware limiting route (the key of config rate_limits, e.g. "/post/create").
+func RateLimit(route string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if !Allow(ctx, c, route, callerKey(ctx, c)) {
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+// Allow takes one token from the bucket of (route, key) and returns true if the request may go on.
+// When refused it aborts with 429 + Retry-After and returns false. Routes without a rule always pass.
+// Handlers use it for limits keyed on request data, e.g. /login by target account.
+func Allow(ctx context.Context, c *app.RequestContext, route, key string) bool {
+	limit := routeLimit(route)
+	if !limit.Valid() {
+		return true
+	}
+
+	bucket := route + "|" + key
+	allowed, retryAfter, err := limiterStore.Take(ctx, bucket, limit, time.Now())
+	if err != nil {
+		// 限流存储出问题时放行，不影响正常请求
+		log.Printf("rate limit: take %s failed: %v", bucket, err)
+		return true
+	}
+	if !allowed {
+		secs := int(math.Ceil(retryAfter.Seconds()))
+		if secs < 1 {
+			secs = 1
+		}
+		c.Header("Retry-After", strconv.Itoa(secs))
+		c.AbortWithStatusJSON(consts.StatusTooManyRequests, utils.H{
+			"isSuccessful": false,
+			"errorMessage": "too many requests, please retry in " + strconv.Itoa(secs) + "s",
+		})
+		return false
+	}
+	return true
+}
+
+// routeLimit reads the rule of route from config (read per request, cheap).
+func routeLimit(route string) ratelimit.Limit {
+	rule, ok := config.GetSpecificConfig().RateLimits[route]
+	if !ok || rule.Requests <= 0 || rule.PeriodSeconds <= 0 {
+		return ratelimit.Limit{}
+	}
+	return ratelimit.PerPeriod(rule.Requests, time.Duration(rule.PeriodSeconds)*time.Second, rule.Burst)
+}
+
+// callerKey is "user:<id>" for a valid JWT, "ip:<client ip>" otherwise.
+// The client ip comes from ClientIPFunc, so forwarded headers only count behind a trusted proxy.
+func callerKey(ctx context.Context, c *app.RequestContext) string {
+	if jwtStr := string(c.Cookie("JWT")); jwtStr != "" {
+		_, _, _, exp, id, err := auth_service.ParseUserJWT(ctx, jwtStr)
+		if err == nil && exp > time.Now().Unix() && id > 0 {
+			return "user:" + strconv.FormatInt(id, 10)
+		}
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// ClientIPFunc builds the app.ClientIP used by the engine (h.SetClientIPFunc).
+// Hertz's default trusts forwarded headers from any peer, so a client could send a new
+// X-Forwarded-For on every request and get a fresh bucket. Here only config trusted_proxies
+// (CIDRs or single IPs) may set them; with none configured the remote address is always used.
+func ClientIPFunc() app.ClientIP {
+	proxies := config.GetSpecificConfig().TrustedProxies
+	cidrs := make([]*net.IPNet, 0, len(proxies))
+	for _, p := range proxies {
+		if !strings.Contains(p, "/") {
+			if ip := net.ParseIP(p); ip != nil && ip.To4() != nil {
+				p += "/32"
+			} else {
+				p += "/128"
+			}
+		}
+		_, cidr, err := net.ParseCIDR(p)
+		if err != nil {
+			log.Fatalf("rate limit: invalid trusted_proxies entry %q: %v", p, err)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+	return app.ClientIPWithOption(app.ClientIPOptions{
+		RemoteIPHeaders: []string{"X-Forwarded-For", "X-Real-IP"},
+		TrustedCIDRs:    cidrs,
+	})
+}
@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"github.com/apache/thrift/lib/go/thrift"
 	"zetian-personal-website-hertz/biz/model/category"
+	"zetian-personal-website-hertz/biz/model/comment"
 	numberoperation "zetian-personal-website-hertz/biz/model/numberOperation"
 	"zetian-personal-website-hertz/biz/model/post"
 	"zetian-personal-website-hertz/biz/model/school"
+	"zetian-personal-website-hertz/biz/model/tag"
 	"zetian-personal-website-hertz/biz/model/user"
 	"zetian-personal-website-hertz/biz/model/verification"
 )
@@ -27,6 +29,10 @@ type UserService interface {
 
 	UpdateAvatar(ctx context.Context, request *user.UpdateAvatarReq) (r *user.UpdateAvatarResp, err error)
 
+	UpdateSchool(ctx context.Context, request *user.UpdateSchoolReq) (r *user.UpdateSchoolResp, err error)
+
+	UpdatePrivacy(ctx context.Context, request *user.UpdatePrivacyReq) (r *user.UpdatePrivacyResp, err error)
+
 	FollowUser(ctx context.Context, request *user.FollowUserReq) (r *user.FollowUserResp, err error)
 
 	UnfollowUser(ctx context.Context, request *user.UnfollowUserReq) (r *user.UnfollowUserResp, err error)
@@ -118,6 +124,24 @@ func (p *UserServiceClient) UpdateAvatar(ctx context.Context, request *user.Upda
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *UserServiceClient) UpdateSchool(ctx context.Context, request *user.UpdateSchoolReq) (r *user.UpdateSchoolResp, err error) {
+	var _args UserServiceUpdateSchoolArgs
+	_args.Request = request
+	var _result UserServiceUpdateSchoolResult
+	if err = p.Client_().Call(ctx, "UpdateSchool", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *UserServiceClient) UpdatePrivacy(ctx context.Context, request *user.UpdatePrivacyReq) (r *user.UpdatePrivacyResp, err error) {
+	var _args UserServiceUpdatePrivacyArgs
+	_args.Request = request
+	var _result UserServiceUpdatePrivacyResult
+	if err = p.Client_().Call(ctx, "UpdatePrivacy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *UserServiceClient) FollowUser(ctx context.Context, request *user.FollowUserReq) (r *user.FollowUserResp, err error) {
 	var _args UserServiceFollowUserArgs
 	_args.Request = request
@@ -269,15 +293,69 @@ func (p *VerificationServiceClient) Me(ctx context.Context, request *verificatio
 type PostService interface {
 	GetPostByID(ctx context.Context, request *post.GetPostByIDReq) (r *post.GetPostByIDResp, err error)
 
+	BatchGetPosts(ctx context.Context, request *post.BatchGetPostsReq) (r *post.BatchGetPostsResp, err error)
+
 	CreatePost(ctx context.Context, request *post.CreatePostReq) (r *post.CreatePostResp, err error)
 
 	EditPost(ctx context.Context, request *post.EditPostReq) (r *post.EditPostResp, err error)
+	//vote on the poll of a post, user is taken from JWT
+	VotePoll(ctx context.Context, request *post.VotePollReq) (r *post.VotePollResp, err error)
+	//drafts / scheduled posts, only the author, user is taken from JWT
+	SaveDraft(ctx context.Context, request *post.SaveDraftReq) (r *post.SaveDraftResp, err error)
+
+	UpdateDraft(ctx context.Context, request *post.UpdateDraftReq) (r *post.UpdateDraftResp, err error)
+
+	PublishDraft(ctx context.Context, request *post.PublishDraftReq) (r *post.PublishDraftResp, err error)
+
+	GetMyDrafts(ctx context.Context, request *post.GetMyDraftsReq) (r *post.GetMyDraftsResp, err error)
+	//earlier versions of an edited post
+	GetPostRevisions(ctx context.Context, request *post.GetPostRevisionsReq) (r *post.GetPostRevisionsResp, err error)
 
 	DeletePost(ctx context.Context, request *post.DeletePostReq) (r *post.DeletePostResp, err error)
+	//trash / restore, only the author, user is taken from JWT
+	GetTrashedPosts(ctx context.Context, request *post.GetTrashedPostsReq) (r *post.GetTrashedPostsResp, err error)
+
+	RestorePost(ctx context.Context, request *post.RestorePostReq) (r *post.RestorePostResp, err error)
 
 	GetSchoolRecentPosts(ctx context.Context, request *post.GetSchoolRecentPostsReq) (r *post.GetSchoolRecentPostsResp, err error)
 
+	GetCategoryRecentPosts(ctx context.Context, request *post.GetCategoryRecentPostsReq) (r *post.GetCategoryRecentPostsResp, err error)
+
+	GetNearbyPosts(ctx context.Context, request *post.GetNearbyPostsReq) (r *post.GetNearbyPostsResp, err error)
+	//posts the caller favorited, only the owner, user is taken from JWT
+	GetFavoritedPosts(ctx context.Context, request *post.GetFavoritedPostsReq) (r *post.GetFavoritedPostsResp, err error)
+	//posts a user liked, visible to others only if the user allows it (show_liked_posts)
+	GetLikedPosts(ctx context.Context, request *post.GetLikedPostsReq) (r *post.GetLikedPostsResp, err error)
+	//users who liked a post
+	GetPostLikers(ctx context.Context, request *post.GetPostLikersReq) (r *post.GetPostLikersResp, err error)
+	//report a post; moderators (config moderator_user_ids) handle the reports
+	ReportPost(ctx context.Context, request *post.ReportPostReq) (r *post.ReportPostResp, err error)
+
+	GetPendingReports(ctx context.Context, request *post.GetPendingReportsReq) (r *post.GetPendingReportsResp, err error)
+
+	ResolvePostReports(ctx context.Context, request *post.ResolvePostReportsReq) (r *post.ResolvePostReportsResp, err error)
+
+	GetModerationActions(ctx context.Context, request *post.GetModerationActionsReq) (r *post.GetModerationActionsResp, err error)
+	//warnings / hides / removals of the caller's own posts
+	GetMyModerationActions(ctx context.Context, request *post.GetMyModerationActionsReq) (r *post.GetModerationActionsResp, err error)
+	//pin / unpin own posts on the profile (max 3)
+	PinPost(ctx context.Context, request *post.PinPostReq) (r *post.PinPostResp, err error)
+
+	UnpinPost(ctx context.Context, request *post.UnpinPostReq) (r *post.UnpinPostResp, err error)
+	//posts quoting a post
+	GetPostQuotes(ctx context.Context, request *post.GetPostQuotesReq) (r *post.GetPostQuotesResp, err error)
+
+	GetMentionedPosts(ctx context.Context, request *post.GetMentionedPostsReq) (r *post.GetMentionedPostsResp, err error)
+
 	GetPersonalRecentPosts(ctx context.Context, request *post.GetPersonalRecentPostsResp) (r *post.GetPersonalRecentPostsReq, err error)
+	//recent posts of everyone the viewer follows, viewer is taken from JWT
+	GetFollowingUsersRecentPosts(ctx context.Context, request *post.GetFollowingUsersRecentPostsReq) (r *post.GetFollowingUsersRecentPostsResp, err error)
+	//posts ordered by hot_score, within one school / across all schools
+	GetSchoolHotPosts(ctx context.Context, request *post.GetSchoolHotPostsReq) (r *post.GetSchoolHotPostsResp, err error)
+
+	GetHotPosts(ctx context.Context, request *post.GetHotPostsReq) (r *post.GetHotPostsResp, err error)
+	//full-text search with filters, viewer is optional (JWT)
+	SearchPosts(ctx context.Context, request *post.SearchPostsReq) (r *post.SearchPostsResp, err error)
 	//like, unlike, fav, unfav will authorize user based on the JWT
 	//user_id likes post_id, but will check whether user_id == JWT
 	//looks like LikePostReq and UserFlagPostResq are typo, TOFIX later
@@ -327,6 +405,15 @@ func (p *PostServiceClient) GetPostByID(ctx context.Context, request *post.GetPo
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *PostServiceClient) BatchGetPosts(ctx context.Context, request *post.BatchGetPostsReq) (r *post.BatchGetPostsResp, err error) {
+	var _args PostServiceBatchGetPostsArgs
+	_args.Request = request
+	var _result PostServiceBatchGetPostsResult
+	if err = p.Client_().Call(ctx, "BatchGetPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *PostServiceClient) CreatePost(ctx context.Context, request *post.CreatePostReq) (r *post.CreatePostResp, err error) {
 	var _args PostServiceCreatePostArgs
 	_args.Request = request
@@ -345,6 +432,60 @@ func (p *PostServiceClient) EditPost(ctx context.Context, request *post.EditPost
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *PostServiceClient) VotePoll(ctx context.Context, request *post.VotePollReq) (r *post.VotePollResp, err error) {
+	var _args PostServiceVotePollArgs
+	_args.Request = request
+	var _result PostServiceVotePollResult
+	if err = p.Client_().Call(ctx, "VotePoll", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) SaveDraft(ctx context.Context, request *post.SaveDraftReq) (r *post.SaveDraftResp, err error) {
+	var _args PostServiceSaveDraftArgs
+	_args.Request = request
+	var _result PostServiceSaveDraftResult
+	if err = p.Client_().Call(ctx, "SaveDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) UpdateDraft(ctx context.Context, request *post.UpdateDraftReq) (r *post.UpdateDraftResp, err error) {
+	var _args PostServiceUpdateDraftArgs
+	_args.Request = request
+	var _result PostServiceUpdateDraftResult
+	if err = p.Client_().Call(ctx, "UpdateDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) PublishDraft(ctx context.Context, request *post.PublishDraftReq) (r *post.PublishDraftResp, err error) {
+	var _args PostServicePublishDraftArgs
+	_args.Request = request
+	var _result PostServicePublishDraftResult
+	if err = p.Client_().Call(ctx, "PublishDraft", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetMyDrafts(ctx context.Context, request *post.GetMyDraftsReq) (r *post.GetMyDraftsResp, err error) {
+	var _args PostServiceGetMyDraftsArgs
+	_args.Request = request
+	var _result PostServiceGetMyDraftsResult
+	if err = p.Client_().Call(ctx, "GetMyDrafts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetPostRevisions(ctx context.Context, request *post.GetPostRevisionsReq) (r *post.GetPostRevisionsResp, err error) {
+	var _args PostServiceGetPostRevisionsArgs
+	_args.Request = request
+	var _result PostServiceGetPostRevisionsResult
+	if err = p.Client_().Call(ctx, "GetPostRevisions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *PostServiceClient) DeletePost(ctx context.Context, request *post.DeletePostReq) (r *post.DeletePostResp, err error) {
 	var _args PostServiceDeletePostArgs
 	_args.Request = request
@@ -354,6 +495,24 @@ func (p *PostServiceClient) DeletePost(ctx context.Context, request *post.Delete
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *PostServiceClient) GetTrashedPosts(ctx context.Context, request *post.GetTrashedPostsReq) (r *post.GetTrashedPostsResp, err error) {
+	var _args PostServiceGetTrashedPostsArgs
+	_args.Request = request
+	var _result PostServiceGetTrashedPostsResult
+	if err = p.Client_().Call(ctx, "GetTrashedPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) RestorePost(ctx context.Context, request *post.RestorePostReq) (r *post.RestorePostResp, err error) {
+	var _args PostServiceRestorePostArgs
+	_args.Request = request
+	var _result PostServiceRestorePostResult
+	if err = p.Client_().Call(ctx, "RestorePost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *PostServiceClient) GetSchoolRecentPosts(ctx context.Context, request *post.GetSchoolRecentPostsReq) (r *post.GetSchoolRecentPostsResp, err error) {
 	var _args PostServiceGetSchoolRecentPostsArgs
 	_args.Request = request
@@ -363,6 +522,132 @@ func (p *PostServiceClient) GetSchoolRecentPosts(ctx context.Context, request *p
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *PostServiceClient) GetCategoryRecentPosts(ctx context.Context, request *post.GetCategoryRecentPostsReq) (r *post.GetCategoryRecentPostsResp, err error) {
+	var _args PostServiceGetCategoryRecentPostsArgs
+	_args.Request = request
+	var _result PostServiceGetCategoryRecentPostsResult
+	if err = p.Client_().Call(ctx, "GetCategoryRecentPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetNearbyPosts(ctx context.Context, request *post.GetNearbyPostsReq) (r *post.GetNearbyPostsResp, err error) {
+	var _args PostServiceGetNearbyPostsArgs
+	_args.Request = request
+	var _result PostServiceGetNearbyPostsResult
+	if err = p.Client_().Call(ctx, "GetNearbyPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetFavoritedPosts(ctx context.Context, request *post.GetFavoritedPostsReq) (r *post.GetFavoritedPostsResp, err error) {
+	var _args PostServiceGetFavoritedPostsArgs
+	_args.Request = request
+	var _result PostServiceGetFavoritedPostsResult
+	if err = p.Client_().Call(ctx, "GetFavoritedPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetLikedPosts(ctx context.Context, request *post.GetLikedPostsReq) (r *post.GetLikedPostsResp, err error) {
+	var _args PostServiceGetLikedPostsArgs
+	_args.Request = request
+	var _result PostServiceGetLikedPostsResult
+	if err = p.Client_().Call(ctx, "GetLikedPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetPostLikers(ctx context.Context, request *post.GetPostLikersReq) (r *post.GetPostLikersResp, err error) {
+	var _args PostServiceGetPostLikersArgs
+	_args.Request = request
+	var _result PostServiceGetPostLikersResult
+	if err = p.Client_().Call(ctx, "GetPostLikers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) ReportPost(ctx context.Context, request *post.ReportPostReq) (r *post.ReportPostResp, err error) {
+	var _args PostServiceReportPostArgs
+	_args.Request = request
+	var _result PostServiceReportPostResult
+	if err = p.Client_().Call(ctx, "ReportPost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetPendingReports(ctx context.Context, request *post.GetPendingReportsReq) (r *post.GetPendingReportsResp, err error) {
+	var _args PostServiceGetPendingReportsArgs
+	_args.Request = request
+	var _result PostServiceGetPendingReportsResult
+	if err = p.Client_().Call(ctx, "GetPendingReports", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) ResolvePostReports(ctx context.Context, request *post.ResolvePostReportsReq) (r *post.ResolvePostReportsResp, err error) {
+	var _args PostServiceResolvePostReportsArgs
+	_args.Request = request
+	var _result PostServiceResolvePostReportsResult
+	if err = p.Client_().Call(ctx, "ResolvePostReports", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetModerationActions(ctx context.Context, request *post.GetModerationActionsReq) (r *post.GetModerationActionsResp, err error) {
+	var _args PostServiceGetModerationActionsArgs
+	_args.Request = request
+	var _result PostServiceGetModerationActionsResult
+	if err = p.Client_().Call(ctx, "GetModerationActions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetMyModerationActions(ctx context.Context, request *post.GetMyModerationActionsReq) (r *post.GetModerationActionsResp, err error) {
+	var _args PostServiceGetMyModerationActionsArgs
+	_args.Request = request
+	var _result PostServiceGetMyModerationActionsResult
+	if err = p.Client_().Call(ctx, "GetMyModerationActions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) PinPost(ctx context.Context, request *post.PinPostReq) (r *post.PinPostResp, err error) {
+	var _args PostServicePinPostArgs
+	_args.Request = request
+	var _result PostServicePinPostResult
+	if err = p.Client_().Call(ctx, "PinPost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) UnpinPost(ctx context.Context, request *post.UnpinPostReq) (r *post.UnpinPostResp, err error) {
+	var _args PostServiceUnpinPostArgs
+	_args.Request = request
+	var _result PostServiceUnpinPostResult
+	if err = p.Client_().Call(ctx, "UnpinPost", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetPostQuotes(ctx context.Context, request *post.GetPostQuotesReq) (r *post.GetPostQuotesResp, err error) {
+	var _args PostServiceGetPostQuotesArgs
+	_args.Request = request
+	var _result PostServiceGetPostQuotesResult
+	if err = p.Client_().Call(ctx, "GetPostQuotes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetMentionedPosts(ctx context.Context, request *post.GetMentionedPostsReq) (r *post.GetMentionedPostsResp, err error) {
+	var _args PostServiceGetMentionedPostsArgs
+	_args.Request = request
+	var _result PostServiceGetMentionedPostsResult
+	if err = p.Client_().Call(ctx, "GetMentionedPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *PostServiceClient) GetPersonalRecentPosts(ctx context.Context, request *post.GetPersonalRecentPostsResp) (r *post.GetPersonalRecentPostsReq, err error) {
 	var _args PostServiceGetPersonalRecentPostsArgs
 	_args.Request = request
@@ -372,6 +657,42 @@ func (p *PostServiceClient) GetPersonalRecentPosts(ctx context.Context, request
 	}
 	return _result.GetSuccess(), nil
 }
+func (p *PostServiceClient) GetFollowingUsersRecentPosts(ctx context.Context, request *post.GetFollowingUsersRecentPostsReq) (r *post.GetFollowingUsersRecentPostsResp, err error) {
+	var _args PostServiceGetFollowingUsersRecentPostsArgs
+	_args.Request = request
+	var _result PostServiceGetFollowingUsersRecentPostsResult
+	if err = p.Client_().Call(ctx, "GetFollowingUsersRecentPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetSchoolHotPosts(ctx context.Context, request *post.GetSchoolHotPostsReq) (r *post.GetSchoolHotPostsResp, err error) {
+	var _args PostServiceGetSchoolHotPostsArgs
+	_args.Request = request
+	var _result PostServiceGetSchoolHotPostsResult
+	if err = p.Client_().Call(ctx, "GetSchoolHotPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) GetHotPosts(ctx context.Context, request *post.GetHotPostsReq) (r *post.GetHotPostsResp, err error) {
+	var _args PostServiceGetHotPostsArgs
+	_args.Request = request
+	var _result PostServiceGetHotPostsResult
+	if err = p.Client_().Call(ctx, "GetHotPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *PostServiceClient) SearchPosts(ctx context.Context, request *post.SearchPostsReq) (r *post.SearchPostsResp, err error) {
+	var _args PostServiceSearchPostsArgs
+	_args.Request = request
+	var _result PostServiceSearchPostsResult
+	if err = p.Client_().Call(ctx, "SearchPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
 func (p *PostServiceClient) LikePost(ctx context.Context, request *post.UserFlagPostResq) (r *post.LikePostReq, err error) {
 	var _args PostServiceLikePostArgs
 	_args.Request = request
@@ -498,6 +819,153 @@ func (p *CategoryServiceClient) GetAllCategories(ctx context.Context, request *c
 	return _result.GetSuccess(), nil
 }
 
+// comment, like / unlike will authorize user based on the JWT
+type CommentService interface {
+	CreateComment(ctx context.Context, request *comment.CreateCommentReq) (r *comment.CreateCommentResp, err error)
+
+	DeleteComment(ctx context.Context, request *comment.DeleteCommentReq) (r *comment.DeleteCommentResp, err error)
+
+	GetPostComments(ctx context.Context, request *comment.GetPostCommentsReq) (r *comment.GetPostCommentsResp, err error)
+
+	GetCommentReplies(ctx context.Context, request *comment.GetCommentRepliesReq) (r *comment.GetCommentRepliesResp, err error)
+
+	LikeComment(ctx context.Context, request *comment.LikeCommentReq) (r *comment.UserFlagCommentResp, err error)
+
+	UnlikeComment(ctx context.Context, request *comment.UnlikeCommentReq) (r *comment.UserFlagCommentResp, err error)
+}
+
+type CommentServiceClient struct {
+	c thrift.TClient
+}
+
+func NewCommentServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *CommentServiceClient {
+	return &CommentServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewCommentServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *CommentServiceClient {
+	return &CommentServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewCommentServiceClient(c thrift.TClient) *CommentServiceClient {
+	return &CommentServiceClient{
+		c: c,
+	}
+}
+
+func (p *CommentServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *CommentServiceClient) CreateComment(ctx context.Context, request *comment.CreateCommentReq) (r *comment.CreateCommentResp, err error) {
+	var _args CommentServiceCreateCommentArgs
+	_args.Request = request
+	var _result CommentServiceCreateCommentResult
+	if err = p.Client_().Call(ctx, "CreateComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) DeleteComment(ctx context.Context, request *comment.DeleteCommentReq) (r *comment.DeleteCommentResp, err error) {
+	var _args CommentServiceDeleteCommentArgs
+	_args.Request = request
+	var _result CommentServiceDeleteCommentResult
+	if err = p.Client_().Call(ctx, "DeleteComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) GetPostComments(ctx context.Context, request *comment.GetPostCommentsReq) (r *comment.GetPostCommentsResp, err error) {
+	var _args CommentServiceGetPostCommentsArgs
+	_args.Request = request
+	var _result CommentServiceGetPostCommentsResult
+	if err = p.Client_().Call(ctx, "GetPostComments", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) GetCommentReplies(ctx context.Context, request *comment.GetCommentRepliesReq) (r *comment.GetCommentRepliesResp, err error) {
+	var _args CommentServiceGetCommentRepliesArgs
+	_args.Request = request
+	var _result CommentServiceGetCommentRepliesResult
+	if err = p.Client_().Call(ctx, "GetCommentReplies", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) LikeComment(ctx context.Context, request *comment.LikeCommentReq) (r *comment.UserFlagCommentResp, err error) {
+	var _args CommentServiceLikeCommentArgs
+	_args.Request = request
+	var _result CommentServiceLikeCommentResult
+	if err = p.Client_().Call(ctx, "LikeComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *CommentServiceClient) UnlikeComment(ctx context.Context, request *comment.UnlikeCommentReq) (r *comment.UserFlagCommentResp, err error) {
+	var _args CommentServiceUnlikeCommentArgs
+	_args.Request = request
+	var _result CommentServiceUnlikeCommentResult
+	if err = p.Client_().Call(ctx, "UnlikeComment", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+type TagService interface {
+	GetTagPosts(ctx context.Context, request *tag.GetTagPostsReq) (r *tag.GetTagPostsResp, err error)
+
+	GetTrendingTags(ctx context.Context, request *tag.GetTrendingTagsReq) (r *tag.GetTrendingTagsResp, err error)
+}
+
+type TagServiceClient struct {
+	c thrift.TClient
+}
+
+func NewTagServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *TagServiceClient {
+	return &TagServiceClient{
+		c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+	}
+}
+
+func NewTagServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *TagServiceClient {
+	return &TagServiceClient{
+		c: thrift.NewTStandardClient(iprot, oprot),
+	}
+}
+
+func NewTagServiceClient(c thrift.TClient) *TagServiceClient {
+	return &TagServiceClient{
+		c: c,
+	}
+}
+
+func (p *TagServiceClient) Client_() thrift.TClient {
+	return p.c
+}
+
+func (p *TagServiceClient) GetTagPosts(ctx context.Context, request *tag.GetTagPostsReq) (r *tag.GetTagPostsResp, err error) {
+	var _args TagServiceGetTagPostsArgs
+	_args.Request = request
+	var _result TagServiceGetTagPostsResult
+	if err = p.Client_().Call(ctx, "GetTagPosts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+func (p *TagServiceClient) GetTrendingTags(ctx context.Context, request *tag.GetTrendingTagsReq) (r *tag.GetTrendingTagsResp, err error) {
+	var _args TagServiceGetTrendingTagsArgs
+	_args.Request = request
+	var _result TagServiceGetTrendingTagsResult
+	if err = p.Client_().Call(ctx, "GetTrendingTags", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 type UserServiceProcessor struct {
 	processorMap map[string]thrift.TProcessorFunction
 	handler      UserService
@@ -524,6 +992,8 @@ func NewUserServiceProcessor(handler UserService) *UserServiceProcessor {
 	self.AddToProcessorMap("GetUser", &userServiceProcessorGetUser{handler: handler})
 	self.AddToProcessorMap("ResetPassword", &userServiceProcessorResetPassword{handler: handler})
 	self.AddToProcessorMap("UpdateAvatar", &userServiceProcessorUpdateAvatar{handler: handler})
+	self.AddToProcessorMap("UpdateSchool", &userServiceProcessorUpdateSchool{handler: handler})
+	self.AddToProcessorMap("UpdatePrivacy", &userServiceProcessorUpdatePrivacy{handler: handler})
 	self.AddToProcessorMap("FollowUser", &userServiceProcessorFollowUser{handler: handler})
 	self.AddToProcessorMap("UnfollowUser", &userServiceProcessorUnfollowUser{handler: handler})
 	self.AddToProcessorMap("GetUserProfile", &userServiceProcessorGetUserProfile{handler: handler})
@@ -837,16 +1307,16 @@ func (p *userServiceProcessorUpdateAvatar) Process(ctx context.Context, seqId in
 	return true, err
 }
 
-type userServiceProcessorFollowUser struct {
+type userServiceProcessorUpdateSchool struct {
 	handler UserService
 }
 
-func (p *userServiceProcessorFollowUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := UserServiceFollowUserArgs{}
+func (p *userServiceProcessorUpdateSchool) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUpdateSchoolArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("FollowUser", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("UpdateSchool", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -855,11 +1325,107 @@ func (p *userServiceProcessorFollowUser) Process(ctx context.Context, seqId int3
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := UserServiceFollowUserResult{}
-	var retval *user.FollowUserResp
-	if retval, err2 = p.handler.FollowUser(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FollowUser: "+err2.Error())
-		oprot.WriteMessageBegin("FollowUser", thrift.EXCEPTION, seqId)
+	result := UserServiceUpdateSchoolResult{}
+	var retval *user.UpdateSchoolResp
+	if retval, err2 = p.handler.UpdateSchool(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateSchool: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateSchool", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateSchool", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorUpdatePrivacy struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorUpdatePrivacy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceUpdatePrivacyArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdatePrivacy", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceUpdatePrivacyResult{}
+	var retval *user.UpdatePrivacyResp
+	if retval, err2 = p.handler.UpdatePrivacy(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdatePrivacy: "+err2.Error())
+		oprot.WriteMessageBegin("UpdatePrivacy", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdatePrivacy", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type userServiceProcessorFollowUser struct {
+	handler UserService
+}
+
+func (p *userServiceProcessorFollowUser) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := UserServiceFollowUserArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("FollowUser", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := UserServiceFollowUserResult{}
+	var retval *user.FollowUserResp
+	if retval, err2 = p.handler.FollowUser(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FollowUser: "+err2.Error())
+		oprot.WriteMessageBegin("FollowUser", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -2841,35 +3407,35 @@ func (p *UserServiceUpdateAvatarResult) String() string {
 
 }
 
-type UserServiceFollowUserArgs struct {
-	Request *user.FollowUserReq `thrift:"request,1"`
+type UserServiceUpdateSchoolArgs struct {
+	Request *user.UpdateSchoolReq `thrift:"request,1"`
 }
 
-func NewUserServiceFollowUserArgs() *UserServiceFollowUserArgs {
-	return &UserServiceFollowUserArgs{}
+func NewUserServiceUpdateSchoolArgs() *UserServiceUpdateSchoolArgs {
+	return &UserServiceUpdateSchoolArgs{}
 }
 
-func (p *UserServiceFollowUserArgs) InitDefault() {
+func (p *UserServiceUpdateSchoolArgs) InitDefault() {
 }
 
-var UserServiceFollowUserArgs_Request_DEFAULT *user.FollowUserReq
+var UserServiceUpdateSchoolArgs_Request_DEFAULT *user.UpdateSchoolReq
 
-func (p *UserServiceFollowUserArgs) GetRequest() (v *user.FollowUserReq) {
+func (p *UserServiceUpdateSchoolArgs) GetRequest() (v *user.UpdateSchoolReq) {
 	if !p.IsSetRequest() {
-		return UserServiceFollowUserArgs_Request_DEFAULT
+		return UserServiceUpdateSchoolArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceFollowUserArgs = map[int16]string{
+var fieldIDToName_UserServiceUpdateSchoolArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceFollowUserArgs) IsSetRequest() bool {
+func (p *UserServiceUpdateSchoolArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceFollowUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateSchoolArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -2915,7 +3481,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceFollowUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateSchoolArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -2925,8 +3491,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceFollowUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewFollowUserReq()
+func (p *UserServiceUpdateSchoolArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewUpdateSchoolReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -2934,9 +3500,9 @@ func (p *UserServiceFollowUserArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceFollowUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateSchoolArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FollowUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSchool_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -2962,7 +3528,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceFollowUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateSchoolArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -2979,43 +3545,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceFollowUserArgs) String() string {
+func (p *UserServiceUpdateSchoolArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceFollowUserArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateSchoolArgs(%+v)", *p)
 
 }
 
-type UserServiceFollowUserResult struct {
-	Success *user.FollowUserResp `thrift:"success,0,optional"`
+type UserServiceUpdateSchoolResult struct {
+	Success *user.UpdateSchoolResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceFollowUserResult() *UserServiceFollowUserResult {
-	return &UserServiceFollowUserResult{}
+func NewUserServiceUpdateSchoolResult() *UserServiceUpdateSchoolResult {
+	return &UserServiceUpdateSchoolResult{}
 }
 
-func (p *UserServiceFollowUserResult) InitDefault() {
+func (p *UserServiceUpdateSchoolResult) InitDefault() {
 }
 
-var UserServiceFollowUserResult_Success_DEFAULT *user.FollowUserResp
+var UserServiceUpdateSchoolResult_Success_DEFAULT *user.UpdateSchoolResp
 
-func (p *UserServiceFollowUserResult) GetSuccess() (v *user.FollowUserResp) {
+func (p *UserServiceUpdateSchoolResult) GetSuccess() (v *user.UpdateSchoolResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceFollowUserResult_Success_DEFAULT
+		return UserServiceUpdateSchoolResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceFollowUserResult = map[int16]string{
+var fieldIDToName_UserServiceUpdateSchoolResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceFollowUserResult) IsSetSuccess() bool {
+func (p *UserServiceUpdateSchoolResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceFollowUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateSchoolResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3061,7 +3627,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceFollowUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdateSchoolResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3071,8 +3637,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceFollowUserResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewFollowUserResp()
+func (p *UserServiceUpdateSchoolResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewUpdateSchoolResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3080,9 +3646,9 @@ func (p *UserServiceFollowUserResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceFollowUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateSchoolResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FollowUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdateSchool_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3108,7 +3674,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceFollowUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdateSchoolResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3127,43 +3693,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceFollowUserResult) String() string {
+func (p *UserServiceUpdateSchoolResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceFollowUserResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdateSchoolResult(%+v)", *p)
 
 }
 
-type UserServiceUnfollowUserArgs struct {
-	Request *user.UnfollowUserReq `thrift:"request,1"`
+type UserServiceUpdatePrivacyArgs struct {
+	Request *user.UpdatePrivacyReq `thrift:"request,1"`
 }
 
-func NewUserServiceUnfollowUserArgs() *UserServiceUnfollowUserArgs {
-	return &UserServiceUnfollowUserArgs{}
+func NewUserServiceUpdatePrivacyArgs() *UserServiceUpdatePrivacyArgs {
+	return &UserServiceUpdatePrivacyArgs{}
 }
 
-func (p *UserServiceUnfollowUserArgs) InitDefault() {
+func (p *UserServiceUpdatePrivacyArgs) InitDefault() {
 }
 
-var UserServiceUnfollowUserArgs_Request_DEFAULT *user.UnfollowUserReq
+var UserServiceUpdatePrivacyArgs_Request_DEFAULT *user.UpdatePrivacyReq
 
-func (p *UserServiceUnfollowUserArgs) GetRequest() (v *user.UnfollowUserReq) {
+func (p *UserServiceUpdatePrivacyArgs) GetRequest() (v *user.UpdatePrivacyReq) {
 	if !p.IsSetRequest() {
-		return UserServiceUnfollowUserArgs_Request_DEFAULT
+		return UserServiceUpdatePrivacyArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceUnfollowUserArgs = map[int16]string{
+var fieldIDToName_UserServiceUpdatePrivacyArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceUnfollowUserArgs) IsSetRequest() bool {
+func (p *UserServiceUpdatePrivacyArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceUnfollowUserArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdatePrivacyArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3209,7 +3775,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUnfollowUserArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdatePrivacyArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3219,8 +3785,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUnfollowUserArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewUnfollowUserReq()
+func (p *UserServiceUpdatePrivacyArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewUpdatePrivacyReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3228,9 +3794,9 @@ func (p *UserServiceUnfollowUserArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceUnfollowUserArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdatePrivacyArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UnfollowUser_args"); err != nil {
+	if err = oprot.WriteStructBegin("UpdatePrivacy_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3256,7 +3822,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUnfollowUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdatePrivacyArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3273,43 +3839,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceUnfollowUserArgs) String() string {
+func (p *UserServiceUpdatePrivacyArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUnfollowUserArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdatePrivacyArgs(%+v)", *p)
 
 }
 
-type UserServiceUnfollowUserResult struct {
-	Success *user.UnfollowUserResp `thrift:"success,0,optional"`
+type UserServiceUpdatePrivacyResult struct {
+	Success *user.UpdatePrivacyResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceUnfollowUserResult() *UserServiceUnfollowUserResult {
-	return &UserServiceUnfollowUserResult{}
+func NewUserServiceUpdatePrivacyResult() *UserServiceUpdatePrivacyResult {
+	return &UserServiceUpdatePrivacyResult{}
 }
 
-func (p *UserServiceUnfollowUserResult) InitDefault() {
+func (p *UserServiceUpdatePrivacyResult) InitDefault() {
 }
 
-var UserServiceUnfollowUserResult_Success_DEFAULT *user.UnfollowUserResp
+var UserServiceUpdatePrivacyResult_Success_DEFAULT *user.UpdatePrivacyResp
 
-func (p *UserServiceUnfollowUserResult) GetSuccess() (v *user.UnfollowUserResp) {
+func (p *UserServiceUpdatePrivacyResult) GetSuccess() (v *user.UpdatePrivacyResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceUnfollowUserResult_Success_DEFAULT
+		return UserServiceUpdatePrivacyResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceUnfollowUserResult = map[int16]string{
+var fieldIDToName_UserServiceUpdatePrivacyResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceUnfollowUserResult) IsSetSuccess() bool {
+func (p *UserServiceUpdatePrivacyResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUnfollowUserResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdatePrivacyResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3355,7 +3921,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUnfollowUserResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUpdatePrivacyResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3365,8 +3931,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceUnfollowUserResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewUnfollowUserResp()
+func (p *UserServiceUpdatePrivacyResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewUpdatePrivacyResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3374,9 +3940,9 @@ func (p *UserServiceUnfollowUserResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceUnfollowUserResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdatePrivacyResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UnfollowUser_result"); err != nil {
+	if err = oprot.WriteStructBegin("UpdatePrivacy_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3402,7 +3968,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceUnfollowUserResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUpdatePrivacyResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3421,43 +3987,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceUnfollowUserResult) String() string {
+func (p *UserServiceUpdatePrivacyResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUnfollowUserResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdatePrivacyResult(%+v)", *p)
 
 }
 
-type UserServiceGetUserProfileArgs struct {
-	Request *user.GetUserProfileReq `thrift:"request,1"`
+type UserServiceFollowUserArgs struct {
+	Request *user.FollowUserReq `thrift:"request,1"`
 }
 
-func NewUserServiceGetUserProfileArgs() *UserServiceGetUserProfileArgs {
-	return &UserServiceGetUserProfileArgs{}
+func NewUserServiceFollowUserArgs() *UserServiceFollowUserArgs {
+	return &UserServiceFollowUserArgs{}
 }
 
-func (p *UserServiceGetUserProfileArgs) InitDefault() {
+func (p *UserServiceFollowUserArgs) InitDefault() {
 }
 
-var UserServiceGetUserProfileArgs_Request_DEFAULT *user.GetUserProfileReq
+var UserServiceFollowUserArgs_Request_DEFAULT *user.FollowUserReq
 
-func (p *UserServiceGetUserProfileArgs) GetRequest() (v *user.GetUserProfileReq) {
+func (p *UserServiceFollowUserArgs) GetRequest() (v *user.FollowUserReq) {
 	if !p.IsSetRequest() {
-		return UserServiceGetUserProfileArgs_Request_DEFAULT
+		return UserServiceFollowUserArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceGetUserProfileArgs = map[int16]string{
+var fieldIDToName_UserServiceFollowUserArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceGetUserProfileArgs) IsSetRequest() bool {
+func (p *UserServiceFollowUserArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceGetUserProfileArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceFollowUserArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3503,7 +4069,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserProfileArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceFollowUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3513,8 +4079,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserProfileArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewGetUserProfileReq()
+func (p *UserServiceFollowUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewFollowUserReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3522,9 +4088,9 @@ func (p *UserServiceGetUserProfileArgs) ReadField1(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceGetUserProfileArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceFollowUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserProfile_args"); err != nil {
+	if err = oprot.WriteStructBegin("FollowUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3550,7 +4116,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceFollowUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3567,43 +4133,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceGetUserProfileArgs) String() string {
+func (p *UserServiceFollowUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserProfileArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceFollowUserArgs(%+v)", *p)
 
 }
 
-type UserServiceGetUserProfileResult struct {
-	Success *user.GetUserProfileResp `thrift:"success,0,optional"`
+type UserServiceFollowUserResult struct {
+	Success *user.FollowUserResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceGetUserProfileResult() *UserServiceGetUserProfileResult {
-	return &UserServiceGetUserProfileResult{}
+func NewUserServiceFollowUserResult() *UserServiceFollowUserResult {
+	return &UserServiceFollowUserResult{}
 }
 
-func (p *UserServiceGetUserProfileResult) InitDefault() {
+func (p *UserServiceFollowUserResult) InitDefault() {
 }
 
-var UserServiceGetUserProfileResult_Success_DEFAULT *user.GetUserProfileResp
+var UserServiceFollowUserResult_Success_DEFAULT *user.FollowUserResp
 
-func (p *UserServiceGetUserProfileResult) GetSuccess() (v *user.GetUserProfileResp) {
+func (p *UserServiceFollowUserResult) GetSuccess() (v *user.FollowUserResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetUserProfileResult_Success_DEFAULT
+		return UserServiceFollowUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceGetUserProfileResult = map[int16]string{
+var fieldIDToName_UserServiceFollowUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceGetUserProfileResult) IsSetSuccess() bool {
+func (p *UserServiceFollowUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetUserProfileResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceFollowUserResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3649,7 +4215,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserProfileResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceFollowUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3659,8 +4225,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserProfileResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewGetUserProfileResp()
+func (p *UserServiceFollowUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewFollowUserResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3668,9 +4234,9 @@ func (p *UserServiceGetUserProfileResult) ReadField0(iprot thrift.TProtocol) err
 	return nil
 }
 
-func (p *UserServiceGetUserProfileResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceFollowUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetUserProfile_result"); err != nil {
+	if err = oprot.WriteStructBegin("FollowUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3696,7 +4262,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetUserProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceFollowUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -3715,43 +4281,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceGetUserProfileResult) String() string {
+func (p *UserServiceFollowUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetUserProfileResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceFollowUserResult(%+v)", *p)
 
 }
 
-type UserServiceGetFolloweesArgs struct {
-	Request *user.GetFolloweesReq `thrift:"request,1"`
+type UserServiceUnfollowUserArgs struct {
+	Request *user.UnfollowUserReq `thrift:"request,1"`
 }
 
-func NewUserServiceGetFolloweesArgs() *UserServiceGetFolloweesArgs {
-	return &UserServiceGetFolloweesArgs{}
+func NewUserServiceUnfollowUserArgs() *UserServiceUnfollowUserArgs {
+	return &UserServiceUnfollowUserArgs{}
 }
 
-func (p *UserServiceGetFolloweesArgs) InitDefault() {
+func (p *UserServiceUnfollowUserArgs) InitDefault() {
 }
 
-var UserServiceGetFolloweesArgs_Request_DEFAULT *user.GetFolloweesReq
+var UserServiceUnfollowUserArgs_Request_DEFAULT *user.UnfollowUserReq
 
-func (p *UserServiceGetFolloweesArgs) GetRequest() (v *user.GetFolloweesReq) {
+func (p *UserServiceUnfollowUserArgs) GetRequest() (v *user.UnfollowUserReq) {
 	if !p.IsSetRequest() {
-		return UserServiceGetFolloweesArgs_Request_DEFAULT
+		return UserServiceUnfollowUserArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceGetFolloweesArgs = map[int16]string{
+var fieldIDToName_UserServiceUnfollowUserArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceGetFolloweesArgs) IsSetRequest() bool {
+func (p *UserServiceUnfollowUserArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceGetFolloweesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUnfollowUserArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3797,7 +4363,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetFolloweesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUnfollowUserArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3807,8 +4373,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetFolloweesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewGetFolloweesReq()
+func (p *UserServiceUnfollowUserArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewUnfollowUserReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3816,9 +4382,9 @@ func (p *UserServiceGetFolloweesArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetFolloweesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUnfollowUserArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowees_args"); err != nil {
+	if err = oprot.WriteStructBegin("UnfollowUser_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3844,7 +4410,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetFolloweesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUnfollowUserArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -3861,43 +4427,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceGetFolloweesArgs) String() string {
+func (p *UserServiceUnfollowUserArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetFolloweesArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceUnfollowUserArgs(%+v)", *p)
 
 }
 
-type UserServiceGetFolloweesResult struct {
-	Success *user.GetFolloweesResp `thrift:"success,0,optional"`
+type UserServiceUnfollowUserResult struct {
+	Success *user.UnfollowUserResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceGetFolloweesResult() *UserServiceGetFolloweesResult {
-	return &UserServiceGetFolloweesResult{}
+func NewUserServiceUnfollowUserResult() *UserServiceUnfollowUserResult {
+	return &UserServiceUnfollowUserResult{}
 }
 
-func (p *UserServiceGetFolloweesResult) InitDefault() {
+func (p *UserServiceUnfollowUserResult) InitDefault() {
 }
 
-var UserServiceGetFolloweesResult_Success_DEFAULT *user.GetFolloweesResp
+var UserServiceUnfollowUserResult_Success_DEFAULT *user.UnfollowUserResp
 
-func (p *UserServiceGetFolloweesResult) GetSuccess() (v *user.GetFolloweesResp) {
+func (p *UserServiceUnfollowUserResult) GetSuccess() (v *user.UnfollowUserResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetFolloweesResult_Success_DEFAULT
+		return UserServiceUnfollowUserResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceGetFolloweesResult = map[int16]string{
+var fieldIDToName_UserServiceUnfollowUserResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceGetFolloweesResult) IsSetSuccess() bool {
+func (p *UserServiceUnfollowUserResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetFolloweesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceUnfollowUserResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -3943,7 +4509,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetFolloweesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceUnfollowUserResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -3953,8 +4519,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetFolloweesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewGetFolloweesResp()
+func (p *UserServiceUnfollowUserResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewUnfollowUserResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -3962,9 +4528,9 @@ func (p *UserServiceGetFolloweesResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceGetFolloweesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUnfollowUserResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowees_result"); err != nil {
+	if err = oprot.WriteStructBegin("UnfollowUser_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -3990,7 +4556,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetFolloweesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceUnfollowUserResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4009,43 +4575,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceGetFolloweesResult) String() string {
+func (p *UserServiceUnfollowUserResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetFolloweesResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUnfollowUserResult(%+v)", *p)
 
 }
 
-type UserServiceGetFollowersArgs struct {
-	Request *user.GetFollowersReq `thrift:"request,1"`
+type UserServiceGetUserProfileArgs struct {
+	Request *user.GetUserProfileReq `thrift:"request,1"`
 }
 
-func NewUserServiceGetFollowersArgs() *UserServiceGetFollowersArgs {
-	return &UserServiceGetFollowersArgs{}
+func NewUserServiceGetUserProfileArgs() *UserServiceGetUserProfileArgs {
+	return &UserServiceGetUserProfileArgs{}
 }
 
-func (p *UserServiceGetFollowersArgs) InitDefault() {
+func (p *UserServiceGetUserProfileArgs) InitDefault() {
 }
 
-var UserServiceGetFollowersArgs_Request_DEFAULT *user.GetFollowersReq
+var UserServiceGetUserProfileArgs_Request_DEFAULT *user.GetUserProfileReq
 
-func (p *UserServiceGetFollowersArgs) GetRequest() (v *user.GetFollowersReq) {
+func (p *UserServiceGetUserProfileArgs) GetRequest() (v *user.GetUserProfileReq) {
 	if !p.IsSetRequest() {
-		return UserServiceGetFollowersArgs_Request_DEFAULT
+		return UserServiceGetUserProfileArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_UserServiceGetFollowersArgs = map[int16]string{
+var fieldIDToName_UserServiceGetUserProfileArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *UserServiceGetFollowersArgs) IsSetRequest() bool {
+func (p *UserServiceGetUserProfileArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *UserServiceGetFollowersArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserProfileArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4091,7 +4657,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetFollowersArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserProfileArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4101,8 +4667,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetFollowersArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := user.NewGetFollowersReq()
+func (p *UserServiceGetUserProfileArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewGetUserProfileReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4110,9 +4676,9 @@ func (p *UserServiceGetFollowersArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *UserServiceGetFollowersArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserProfileArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowers_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserProfile_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4138,7 +4704,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetFollowersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserProfileArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4155,43 +4721,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *UserServiceGetFollowersArgs) String() string {
+func (p *UserServiceGetUserProfileArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetFollowersArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetUserProfileArgs(%+v)", *p)
 
 }
 
-type UserServiceGetFollowersResult struct {
-	Success *user.GetFollowersResp `thrift:"success,0,optional"`
+type UserServiceGetUserProfileResult struct {
+	Success *user.GetUserProfileResp `thrift:"success,0,optional"`
 }
 
-func NewUserServiceGetFollowersResult() *UserServiceGetFollowersResult {
-	return &UserServiceGetFollowersResult{}
+func NewUserServiceGetUserProfileResult() *UserServiceGetUserProfileResult {
+	return &UserServiceGetUserProfileResult{}
 }
 
-func (p *UserServiceGetFollowersResult) InitDefault() {
+func (p *UserServiceGetUserProfileResult) InitDefault() {
 }
 
-var UserServiceGetFollowersResult_Success_DEFAULT *user.GetFollowersResp
+var UserServiceGetUserProfileResult_Success_DEFAULT *user.GetUserProfileResp
 
-func (p *UserServiceGetFollowersResult) GetSuccess() (v *user.GetFollowersResp) {
+func (p *UserServiceGetUserProfileResult) GetSuccess() (v *user.GetUserProfileResp) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetFollowersResult_Success_DEFAULT
+		return UserServiceGetUserProfileResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_UserServiceGetFollowersResult = map[int16]string{
+var fieldIDToName_UserServiceGetUserProfileResult = map[int16]string{
 	0: "success",
 }
 
-func (p *UserServiceGetFollowersResult) IsSetSuccess() bool {
+func (p *UserServiceGetUserProfileResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetFollowersResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserProfileResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4237,7 +4803,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetFollowersResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetUserProfileResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4247,8 +4813,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *UserServiceGetFollowersResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := user.NewGetFollowersResp()
+func (p *UserServiceGetUserProfileResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewGetUserProfileResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4256,9 +4822,9 @@ func (p *UserServiceGetFollowersResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *UserServiceGetFollowersResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserProfileResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetFollowers_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetUserProfile_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4284,7 +4850,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *UserServiceGetFollowersResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetUserProfileResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4303,132 +4869,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *UserServiceGetFollowersResult) String() string {
+func (p *UserServiceGetUserProfileResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetFollowersResult(%+v)", *p)
-
-}
-
-type NumberOperationServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      NumberOperationService
-}
-
-func (p *NumberOperationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
-
-func (p *NumberOperationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
-
-func (p *NumberOperationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
-
-func NewNumberOperationServiceProcessor(handler NumberOperationService) *NumberOperationServiceProcessor {
-	self := &NumberOperationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetToBinary", &numberOperationServiceProcessorGetToBinary{handler: handler})
-	return self
-}
-func (p *NumberOperationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
-
-type numberOperationServiceProcessorGetToBinary struct {
-	handler NumberOperationService
-}
-
-func (p *numberOperationServiceProcessorGetToBinary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := NumberOperationServiceGetToBinaryArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetToBinary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+	return fmt.Sprintf("UserServiceGetUserProfileResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := NumberOperationServiceGetToBinaryResult{}
-	var retval *numberoperation.GetToBinaryResp
-	if retval, err2 = p.handler.GetToBinary(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetToBinary: "+err2.Error())
-		oprot.WriteMessageBegin("GetToBinary", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetToBinary", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type NumberOperationServiceGetToBinaryArgs struct {
-	Request *numberoperation.GetToBinaryReq `thrift:"request,1"`
+type UserServiceGetFolloweesArgs struct {
+	Request *user.GetFolloweesReq `thrift:"request,1"`
 }
 
-func NewNumberOperationServiceGetToBinaryArgs() *NumberOperationServiceGetToBinaryArgs {
-	return &NumberOperationServiceGetToBinaryArgs{}
+func NewUserServiceGetFolloweesArgs() *UserServiceGetFolloweesArgs {
+	return &UserServiceGetFolloweesArgs{}
 }
 
-func (p *NumberOperationServiceGetToBinaryArgs) InitDefault() {
+func (p *UserServiceGetFolloweesArgs) InitDefault() {
 }
 
-var NumberOperationServiceGetToBinaryArgs_Request_DEFAULT *numberoperation.GetToBinaryReq
+var UserServiceGetFolloweesArgs_Request_DEFAULT *user.GetFolloweesReq
 
-func (p *NumberOperationServiceGetToBinaryArgs) GetRequest() (v *numberoperation.GetToBinaryReq) {
+func (p *UserServiceGetFolloweesArgs) GetRequest() (v *user.GetFolloweesReq) {
 	if !p.IsSetRequest() {
-		return NumberOperationServiceGetToBinaryArgs_Request_DEFAULT
+		return UserServiceGetFolloweesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_NumberOperationServiceGetToBinaryArgs = map[int16]string{
+var fieldIDToName_UserServiceGetFolloweesArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *NumberOperationServiceGetToBinaryArgs) IsSetRequest() bool {
+func (p *UserServiceGetFolloweesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *NumberOperationServiceGetToBinaryArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFolloweesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4474,7 +4951,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NumberOperationServiceGetToBinaryArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetFolloweesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4484,8 +4961,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NumberOperationServiceGetToBinaryArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := numberoperation.NewGetToBinaryReq()
+func (p *UserServiceGetFolloweesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewGetFolloweesReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4493,9 +4970,9 @@ func (p *NumberOperationServiceGetToBinaryArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *NumberOperationServiceGetToBinaryArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFolloweesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetToBinary_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowees_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4521,7 +4998,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NumberOperationServiceGetToBinaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFolloweesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -4538,43 +5015,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *NumberOperationServiceGetToBinaryArgs) String() string {
+func (p *UserServiceGetFolloweesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NumberOperationServiceGetToBinaryArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetFolloweesArgs(%+v)", *p)
 
 }
 
-type NumberOperationServiceGetToBinaryResult struct {
-	Success *numberoperation.GetToBinaryResp `thrift:"success,0,optional"`
+type UserServiceGetFolloweesResult struct {
+	Success *user.GetFolloweesResp `thrift:"success,0,optional"`
 }
 
-func NewNumberOperationServiceGetToBinaryResult() *NumberOperationServiceGetToBinaryResult {
-	return &NumberOperationServiceGetToBinaryResult{}
+func NewUserServiceGetFolloweesResult() *UserServiceGetFolloweesResult {
+	return &UserServiceGetFolloweesResult{}
 }
 
-func (p *NumberOperationServiceGetToBinaryResult) InitDefault() {
+func (p *UserServiceGetFolloweesResult) InitDefault() {
 }
 
-var NumberOperationServiceGetToBinaryResult_Success_DEFAULT *numberoperation.GetToBinaryResp
+var UserServiceGetFolloweesResult_Success_DEFAULT *user.GetFolloweesResp
 
-func (p *NumberOperationServiceGetToBinaryResult) GetSuccess() (v *numberoperation.GetToBinaryResp) {
+func (p *UserServiceGetFolloweesResult) GetSuccess() (v *user.GetFolloweesResp) {
 	if !p.IsSetSuccess() {
-		return NumberOperationServiceGetToBinaryResult_Success_DEFAULT
+		return UserServiceGetFolloweesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_NumberOperationServiceGetToBinaryResult = map[int16]string{
+var fieldIDToName_UserServiceGetFolloweesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *NumberOperationServiceGetToBinaryResult) IsSetSuccess() bool {
+func (p *UserServiceGetFolloweesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *NumberOperationServiceGetToBinaryResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFolloweesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4620,7 +5097,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NumberOperationServiceGetToBinaryResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetFolloweesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4630,8 +5107,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *NumberOperationServiceGetToBinaryResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := numberoperation.NewGetToBinaryResp()
+func (p *UserServiceGetFolloweesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewGetFolloweesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -4639,9 +5116,9 @@ func (p *NumberOperationServiceGetToBinaryResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *NumberOperationServiceGetToBinaryResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFolloweesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetToBinary_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowees_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -4667,7 +5144,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *NumberOperationServiceGetToBinaryResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFolloweesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -4686,230 +5163,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *NumberOperationServiceGetToBinaryResult) String() string {
+func (p *UserServiceGetFolloweesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("NumberOperationServiceGetToBinaryResult(%+v)", *p)
-
-}
-
-type VerificationServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      VerificationService
-}
-
-func (p *VerificationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
-
-func (p *VerificationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
-
-func (p *VerificationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
-
-func NewVerificationServiceProcessor(handler VerificationService) *VerificationServiceProcessor {
-	self := &VerificationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("SendVeriCodeToEmail", &verificationServiceProcessorSendVeriCodeToEmail{handler: handler})
-	self.AddToProcessorMap("VerifyEmailCode", &verificationServiceProcessorVerifyEmailCode{handler: handler})
-	self.AddToProcessorMap("Me", &verificationServiceProcessorMe{handler: handler})
-	return self
-}
-func (p *VerificationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
-
-type verificationServiceProcessorSendVeriCodeToEmail struct {
-	handler VerificationService
-}
-
-func (p *verificationServiceProcessorSendVeriCodeToEmail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VerificationServiceSendVeriCodeToEmailArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("SendVeriCodeToEmail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VerificationServiceSendVeriCodeToEmailResult{}
-	var retval *verification.SendVeriCodeToEmailResp
-	if retval, err2 = p.handler.SendVeriCodeToEmail(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SendVeriCodeToEmail: "+err2.Error())
-		oprot.WriteMessageBegin("SendVeriCodeToEmail", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("SendVeriCodeToEmail", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
-}
-
-type verificationServiceProcessorVerifyEmailCode struct {
-	handler VerificationService
-}
-
-func (p *verificationServiceProcessorVerifyEmailCode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VerificationServiceVerifyEmailCodeArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("VerifyEmailCode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VerificationServiceVerifyEmailCodeResult{}
-	var retval *verification.VerifyEmailCodeResp
-	if retval, err2 = p.handler.VerifyEmailCode(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VerifyEmailCode: "+err2.Error())
-		oprot.WriteMessageBegin("VerifyEmailCode", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("VerifyEmailCode", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
-}
-
-type verificationServiceProcessorMe struct {
-	handler VerificationService
-}
-
-func (p *verificationServiceProcessorMe) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := VerificationServiceMeArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("Me", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+	return fmt.Sprintf("UserServiceGetFolloweesResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := VerificationServiceMeResult{}
-	var retval *verification.MeResp
-	if retval, err2 = p.handler.Me(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Me: "+err2.Error())
-		oprot.WriteMessageBegin("Me", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("Me", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type VerificationServiceSendVeriCodeToEmailArgs struct {
-	Request *verification.SendVeriCodeToEmailReq `thrift:"request,1"`
+type UserServiceGetFollowersArgs struct {
+	Request *user.GetFollowersReq `thrift:"request,1"`
 }
 
-func NewVerificationServiceSendVeriCodeToEmailArgs() *VerificationServiceSendVeriCodeToEmailArgs {
-	return &VerificationServiceSendVeriCodeToEmailArgs{}
+func NewUserServiceGetFollowersArgs() *UserServiceGetFollowersArgs {
+	return &UserServiceGetFollowersArgs{}
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailArgs) InitDefault() {
+func (p *UserServiceGetFollowersArgs) InitDefault() {
 }
 
-var VerificationServiceSendVeriCodeToEmailArgs_Request_DEFAULT *verification.SendVeriCodeToEmailReq
+var UserServiceGetFollowersArgs_Request_DEFAULT *user.GetFollowersReq
 
-func (p *VerificationServiceSendVeriCodeToEmailArgs) GetRequest() (v *verification.SendVeriCodeToEmailReq) {
+func (p *UserServiceGetFollowersArgs) GetRequest() (v *user.GetFollowersReq) {
 	if !p.IsSetRequest() {
-		return VerificationServiceSendVeriCodeToEmailArgs_Request_DEFAULT
+		return UserServiceGetFollowersArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_VerificationServiceSendVeriCodeToEmailArgs = map[int16]string{
+var fieldIDToName_UserServiceGetFollowersArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailArgs) IsSetRequest() bool {
+func (p *UserServiceGetFollowersArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFollowersArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -4955,7 +5245,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceSendVeriCodeToEmailArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetFollowersArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -4965,18 +5255,18 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := verification.NewSendVeriCodeToEmailReq()
-	if err := _field.Read(iprot); err != nil {
+func (p *UserServiceGetFollowersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := user.NewGetFollowersReq()
+	if err := _field.Read(iprot); err != nil {
 		return err
 	}
 	p.Request = _field
 	return nil
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFollowersArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SendVeriCodeToEmail_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowers_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5002,7 +5292,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFollowersArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5019,43 +5309,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailArgs) String() string {
+func (p *UserServiceGetFollowersArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VerificationServiceSendVeriCodeToEmailArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetFollowersArgs(%+v)", *p)
 
 }
 
-type VerificationServiceSendVeriCodeToEmailResult struct {
-	Success *verification.SendVeriCodeToEmailResp `thrift:"success,0,optional"`
+type UserServiceGetFollowersResult struct {
+	Success *user.GetFollowersResp `thrift:"success,0,optional"`
 }
 
-func NewVerificationServiceSendVeriCodeToEmailResult() *VerificationServiceSendVeriCodeToEmailResult {
-	return &VerificationServiceSendVeriCodeToEmailResult{}
+func NewUserServiceGetFollowersResult() *UserServiceGetFollowersResult {
+	return &UserServiceGetFollowersResult{}
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailResult) InitDefault() {
+func (p *UserServiceGetFollowersResult) InitDefault() {
 }
 
-var VerificationServiceSendVeriCodeToEmailResult_Success_DEFAULT *verification.SendVeriCodeToEmailResp
+var UserServiceGetFollowersResult_Success_DEFAULT *user.GetFollowersResp
 
-func (p *VerificationServiceSendVeriCodeToEmailResult) GetSuccess() (v *verification.SendVeriCodeToEmailResp) {
+func (p *UserServiceGetFollowersResult) GetSuccess() (v *user.GetFollowersResp) {
 	if !p.IsSetSuccess() {
-		return VerificationServiceSendVeriCodeToEmailResult_Success_DEFAULT
+		return UserServiceGetFollowersResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VerificationServiceSendVeriCodeToEmailResult = map[int16]string{
+var fieldIDToName_UserServiceGetFollowersResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailResult) IsSetSuccess() bool {
+func (p *UserServiceGetFollowersResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFollowersResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5101,7 +5391,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceSendVeriCodeToEmailResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_UserServiceGetFollowersResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5111,8 +5401,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := verification.NewSendVeriCodeToEmailResp()
+func (p *UserServiceGetFollowersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := user.NewGetFollowersResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5120,9 +5410,9 @@ func (p *VerificationServiceSendVeriCodeToEmailResult) ReadField0(iprot thrift.T
 	return nil
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFollowersResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("SendVeriCodeToEmail_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetFollowers_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5148,7 +5438,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *UserServiceGetFollowersResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5167,43 +5457,132 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VerificationServiceSendVeriCodeToEmailResult) String() string {
+func (p *UserServiceGetFollowersResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VerificationServiceSendVeriCodeToEmailResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceGetFollowersResult(%+v)", *p)
 
 }
 
-type VerificationServiceVerifyEmailCodeArgs struct {
-	Request *verification.VerifyEmailCodeReq `thrift:"request,1"`
+type NumberOperationServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      NumberOperationService
 }
 
-func NewVerificationServiceVerifyEmailCodeArgs() *VerificationServiceVerifyEmailCodeArgs {
-	return &VerificationServiceVerifyEmailCodeArgs{}
+func (p *NumberOperationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
 }
 
-func (p *VerificationServiceVerifyEmailCodeArgs) InitDefault() {
+func (p *NumberOperationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
 }
 
-var VerificationServiceVerifyEmailCodeArgs_Request_DEFAULT *verification.VerifyEmailCodeReq
+func (p *NumberOperationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
 
-func (p *VerificationServiceVerifyEmailCodeArgs) GetRequest() (v *verification.VerifyEmailCodeReq) {
+func NewNumberOperationServiceProcessor(handler NumberOperationService) *NumberOperationServiceProcessor {
+	self := &NumberOperationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetToBinary", &numberOperationServiceProcessorGetToBinary{handler: handler})
+	return self
+}
+func (p *NumberOperationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type numberOperationServiceProcessorGetToBinary struct {
+	handler NumberOperationService
+}
+
+func (p *numberOperationServiceProcessorGetToBinary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := NumberOperationServiceGetToBinaryArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetToBinary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := NumberOperationServiceGetToBinaryResult{}
+	var retval *numberoperation.GetToBinaryResp
+	if retval, err2 = p.handler.GetToBinary(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetToBinary: "+err2.Error())
+		oprot.WriteMessageBegin("GetToBinary", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetToBinary", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type NumberOperationServiceGetToBinaryArgs struct {
+	Request *numberoperation.GetToBinaryReq `thrift:"request,1"`
+}
+
+func NewNumberOperationServiceGetToBinaryArgs() *NumberOperationServiceGetToBinaryArgs {
+	return &NumberOperationServiceGetToBinaryArgs{}
+}
+
+func (p *NumberOperationServiceGetToBinaryArgs) InitDefault() {
+}
+
+var NumberOperationServiceGetToBinaryArgs_Request_DEFAULT *numberoperation.GetToBinaryReq
+
+func (p *NumberOperationServiceGetToBinaryArgs) GetRequest() (v *numberoperation.GetToBinaryReq) {
 	if !p.IsSetRequest() {
-		return VerificationServiceVerifyEmailCodeArgs_Request_DEFAULT
+		return NumberOperationServiceGetToBinaryArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_VerificationServiceVerifyEmailCodeArgs = map[int16]string{
+var fieldIDToName_NumberOperationServiceGetToBinaryArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *VerificationServiceVerifyEmailCodeArgs) IsSetRequest() bool {
+func (p *NumberOperationServiceGetToBinaryArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *VerificationServiceVerifyEmailCodeArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *NumberOperationServiceGetToBinaryArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5249,7 +5628,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceVerifyEmailCodeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NumberOperationServiceGetToBinaryArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5259,8 +5638,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VerificationServiceVerifyEmailCodeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := verification.NewVerifyEmailCodeReq()
+func (p *NumberOperationServiceGetToBinaryArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := numberoperation.NewGetToBinaryReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5268,9 +5647,9 @@ func (p *VerificationServiceVerifyEmailCodeArgs) ReadField1(iprot thrift.TProtoc
 	return nil
 }
 
-func (p *VerificationServiceVerifyEmailCodeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *NumberOperationServiceGetToBinaryArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VerifyEmailCode_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetToBinary_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5296,7 +5675,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VerificationServiceVerifyEmailCodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *NumberOperationServiceGetToBinaryArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5313,43 +5692,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VerificationServiceVerifyEmailCodeArgs) String() string {
+func (p *NumberOperationServiceGetToBinaryArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VerificationServiceVerifyEmailCodeArgs(%+v)", *p)
+	return fmt.Sprintf("NumberOperationServiceGetToBinaryArgs(%+v)", *p)
 
 }
 
-type VerificationServiceVerifyEmailCodeResult struct {
-	Success *verification.VerifyEmailCodeResp `thrift:"success,0,optional"`
+type NumberOperationServiceGetToBinaryResult struct {
+	Success *numberoperation.GetToBinaryResp `thrift:"success,0,optional"`
 }
 
-func NewVerificationServiceVerifyEmailCodeResult() *VerificationServiceVerifyEmailCodeResult {
-	return &VerificationServiceVerifyEmailCodeResult{}
+func NewNumberOperationServiceGetToBinaryResult() *NumberOperationServiceGetToBinaryResult {
+	return &NumberOperationServiceGetToBinaryResult{}
 }
 
-func (p *VerificationServiceVerifyEmailCodeResult) InitDefault() {
+func (p *NumberOperationServiceGetToBinaryResult) InitDefault() {
 }
 
-var VerificationServiceVerifyEmailCodeResult_Success_DEFAULT *verification.VerifyEmailCodeResp
+var NumberOperationServiceGetToBinaryResult_Success_DEFAULT *numberoperation.GetToBinaryResp
 
-func (p *VerificationServiceVerifyEmailCodeResult) GetSuccess() (v *verification.VerifyEmailCodeResp) {
+func (p *NumberOperationServiceGetToBinaryResult) GetSuccess() (v *numberoperation.GetToBinaryResp) {
 	if !p.IsSetSuccess() {
-		return VerificationServiceVerifyEmailCodeResult_Success_DEFAULT
+		return NumberOperationServiceGetToBinaryResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VerificationServiceVerifyEmailCodeResult = map[int16]string{
+var fieldIDToName_NumberOperationServiceGetToBinaryResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VerificationServiceVerifyEmailCodeResult) IsSetSuccess() bool {
+func (p *NumberOperationServiceGetToBinaryResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VerificationServiceVerifyEmailCodeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *NumberOperationServiceGetToBinaryResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5395,7 +5774,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceVerifyEmailCodeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_NumberOperationServiceGetToBinaryResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5405,8 +5784,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VerificationServiceVerifyEmailCodeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := verification.NewVerifyEmailCodeResp()
+func (p *NumberOperationServiceGetToBinaryResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := numberoperation.NewGetToBinaryResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5414,9 +5793,9 @@ func (p *VerificationServiceVerifyEmailCodeResult) ReadField0(iprot thrift.TProt
 	return nil
 }
 
-func (p *VerificationServiceVerifyEmailCodeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *NumberOperationServiceGetToBinaryResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("VerifyEmailCode_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetToBinary_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5442,7 +5821,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VerificationServiceVerifyEmailCodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *NumberOperationServiceGetToBinaryResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5461,47 +5840,234 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VerificationServiceVerifyEmailCodeResult) String() string {
+func (p *NumberOperationServiceGetToBinaryResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VerificationServiceVerifyEmailCodeResult(%+v)", *p)
-
-}
+	return fmt.Sprintf("NumberOperationServiceGetToBinaryResult(%+v)", *p)
 
-type VerificationServiceMeArgs struct {
-	Request *verification.MeReq `thrift:"request,1"`
 }
 
-func NewVerificationServiceMeArgs() *VerificationServiceMeArgs {
-	return &VerificationServiceMeArgs{}
+type VerificationServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      VerificationService
 }
 
-func (p *VerificationServiceMeArgs) InitDefault() {
+func (p *VerificationServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
 }
 
-var VerificationServiceMeArgs_Request_DEFAULT *verification.MeReq
-
-func (p *VerificationServiceMeArgs) GetRequest() (v *verification.MeReq) {
-	if !p.IsSetRequest() {
-		return VerificationServiceMeArgs_Request_DEFAULT
-	}
-	return p.Request
+func (p *VerificationServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
 }
 
-var fieldIDToName_VerificationServiceMeArgs = map[int16]string{
-	1: "request",
+func (p *VerificationServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
 }
 
-func (p *VerificationServiceMeArgs) IsSetRequest() bool {
-	return p.Request != nil
+func NewVerificationServiceProcessor(handler VerificationService) *VerificationServiceProcessor {
+	self := &VerificationServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("SendVeriCodeToEmail", &verificationServiceProcessorSendVeriCodeToEmail{handler: handler})
+	self.AddToProcessorMap("VerifyEmailCode", &verificationServiceProcessorVerifyEmailCode{handler: handler})
+	self.AddToProcessorMap("Me", &verificationServiceProcessorMe{handler: handler})
+	return self
 }
-
-func (p *VerificationServiceMeArgs) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
+func (p *VerificationServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type verificationServiceProcessorSendVeriCodeToEmail struct {
+	handler VerificationService
+}
+
+func (p *verificationServiceProcessorSendVeriCodeToEmail) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VerificationServiceSendVeriCodeToEmailArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SendVeriCodeToEmail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VerificationServiceSendVeriCodeToEmailResult{}
+	var retval *verification.SendVeriCodeToEmailResp
+	if retval, err2 = p.handler.SendVeriCodeToEmail(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SendVeriCodeToEmail: "+err2.Error())
+		oprot.WriteMessageBegin("SendVeriCodeToEmail", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SendVeriCodeToEmail", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type verificationServiceProcessorVerifyEmailCode struct {
+	handler VerificationService
+}
+
+func (p *verificationServiceProcessorVerifyEmailCode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VerificationServiceVerifyEmailCodeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VerifyEmailCode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VerificationServiceVerifyEmailCodeResult{}
+	var retval *verification.VerifyEmailCodeResp
+	if retval, err2 = p.handler.VerifyEmailCode(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VerifyEmailCode: "+err2.Error())
+		oprot.WriteMessageBegin("VerifyEmailCode", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("VerifyEmailCode", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type verificationServiceProcessorMe struct {
+	handler VerificationService
+}
+
+func (p *verificationServiceProcessorMe) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := VerificationServiceMeArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("Me", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := VerificationServiceMeResult{}
+	var retval *verification.MeResp
+	if retval, err2 = p.handler.Me(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing Me: "+err2.Error())
+		oprot.WriteMessageBegin("Me", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("Me", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type VerificationServiceSendVeriCodeToEmailArgs struct {
+	Request *verification.SendVeriCodeToEmailReq `thrift:"request,1"`
+}
+
+func NewVerificationServiceSendVeriCodeToEmailArgs() *VerificationServiceSendVeriCodeToEmailArgs {
+	return &VerificationServiceSendVeriCodeToEmailArgs{}
+}
+
+func (p *VerificationServiceSendVeriCodeToEmailArgs) InitDefault() {
+}
+
+var VerificationServiceSendVeriCodeToEmailArgs_Request_DEFAULT *verification.SendVeriCodeToEmailReq
+
+func (p *VerificationServiceSendVeriCodeToEmailArgs) GetRequest() (v *verification.SendVeriCodeToEmailReq) {
+	if !p.IsSetRequest() {
+		return VerificationServiceSendVeriCodeToEmailArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_VerificationServiceSendVeriCodeToEmailArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *VerificationServiceSendVeriCodeToEmailArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *VerificationServiceSendVeriCodeToEmailArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
 	if _, err = iprot.ReadStructBegin(); err != nil {
 		goto ReadStructBeginError
 	}
@@ -5543,7 +6109,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceMeArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceSendVeriCodeToEmailArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5553,8 +6119,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VerificationServiceMeArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := verification.NewMeReq()
+func (p *VerificationServiceSendVeriCodeToEmailArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := verification.NewSendVeriCodeToEmailReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5562,9 +6128,9 @@ func (p *VerificationServiceMeArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VerificationServiceMeArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *VerificationServiceSendVeriCodeToEmailArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Me_args"); err != nil {
+	if err = oprot.WriteStructBegin("SendVeriCodeToEmail_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5590,7 +6156,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VerificationServiceMeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *VerificationServiceSendVeriCodeToEmailArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -5607,43 +6173,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *VerificationServiceMeArgs) String() string {
+func (p *VerificationServiceSendVeriCodeToEmailArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VerificationServiceMeArgs(%+v)", *p)
+	return fmt.Sprintf("VerificationServiceSendVeriCodeToEmailArgs(%+v)", *p)
 
 }
 
-type VerificationServiceMeResult struct {
-	Success *verification.MeResp `thrift:"success,0,optional"`
+type VerificationServiceSendVeriCodeToEmailResult struct {
+	Success *verification.SendVeriCodeToEmailResp `thrift:"success,0,optional"`
 }
 
-func NewVerificationServiceMeResult() *VerificationServiceMeResult {
-	return &VerificationServiceMeResult{}
+func NewVerificationServiceSendVeriCodeToEmailResult() *VerificationServiceSendVeriCodeToEmailResult {
+	return &VerificationServiceSendVeriCodeToEmailResult{}
 }
 
-func (p *VerificationServiceMeResult) InitDefault() {
+func (p *VerificationServiceSendVeriCodeToEmailResult) InitDefault() {
 }
 
-var VerificationServiceMeResult_Success_DEFAULT *verification.MeResp
+var VerificationServiceSendVeriCodeToEmailResult_Success_DEFAULT *verification.SendVeriCodeToEmailResp
 
-func (p *VerificationServiceMeResult) GetSuccess() (v *verification.MeResp) {
+func (p *VerificationServiceSendVeriCodeToEmailResult) GetSuccess() (v *verification.SendVeriCodeToEmailResp) {
 	if !p.IsSetSuccess() {
-		return VerificationServiceMeResult_Success_DEFAULT
+		return VerificationServiceSendVeriCodeToEmailResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_VerificationServiceMeResult = map[int16]string{
+var fieldIDToName_VerificationServiceSendVeriCodeToEmailResult = map[int16]string{
 	0: "success",
 }
 
-func (p *VerificationServiceMeResult) IsSetSuccess() bool {
+func (p *VerificationServiceSendVeriCodeToEmailResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VerificationServiceMeResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *VerificationServiceSendVeriCodeToEmailResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -5689,7 +6255,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceMeResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceSendVeriCodeToEmailResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -5699,8 +6265,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *VerificationServiceMeResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := verification.NewMeResp()
+func (p *VerificationServiceSendVeriCodeToEmailResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := verification.NewSendVeriCodeToEmailResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -5708,9 +6274,9 @@ func (p *VerificationServiceMeResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *VerificationServiceMeResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *VerificationServiceSendVeriCodeToEmailResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("Me_result"); err != nil {
+	if err = oprot.WriteStructBegin("SendVeriCodeToEmail_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -5736,7 +6302,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *VerificationServiceMeResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *VerificationServiceSendVeriCodeToEmailResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -5755,184 +6321,2479 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *VerificationServiceMeResult) String() string {
+func (p *VerificationServiceSendVeriCodeToEmailResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VerificationServiceMeResult(%+v)", *p)
+	return fmt.Sprintf("VerificationServiceSendVeriCodeToEmailResult(%+v)", *p)
 
 }
 
-type PostServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      PostService
+type VerificationServiceVerifyEmailCodeArgs struct {
+	Request *verification.VerifyEmailCodeReq `thrift:"request,1"`
 }
 
-func (p *PostServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
+func NewVerificationServiceVerifyEmailCodeArgs() *VerificationServiceVerifyEmailCodeArgs {
+	return &VerificationServiceVerifyEmailCodeArgs{}
 }
 
-func (p *PostServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
+func (p *VerificationServiceVerifyEmailCodeArgs) InitDefault() {
 }
 
-func (p *PostServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
+var VerificationServiceVerifyEmailCodeArgs_Request_DEFAULT *verification.VerifyEmailCodeReq
 
-func NewPostServiceProcessor(handler PostService) *PostServiceProcessor {
-	self := &PostServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetPostByID", &postServiceProcessorGetPostByID{handler: handler})
-	self.AddToProcessorMap("CreatePost", &postServiceProcessorCreatePost{handler: handler})
-	self.AddToProcessorMap("EditPost", &postServiceProcessorEditPost{handler: handler})
-	self.AddToProcessorMap("DeletePost", &postServiceProcessorDeletePost{handler: handler})
-	self.AddToProcessorMap("GetSchoolRecentPosts", &postServiceProcessorGetSchoolRecentPosts{handler: handler})
-	self.AddToProcessorMap("GetPersonalRecentPosts", &postServiceProcessorGetPersonalRecentPosts{handler: handler})
-	self.AddToProcessorMap("LikePost", &postServiceProcessorLikePost{handler: handler})
-	self.AddToProcessorMap("UnlikePost", &postServiceProcessorUnlikePost{handler: handler})
-	self.AddToProcessorMap("FavPost", &postServiceProcessorFavPost{handler: handler})
-	self.AddToProcessorMap("UnfavPost", &postServiceProcessorUnfavPost{handler: handler})
-	self.AddToProcessorMap("UploadPostMedia", &postServiceProcessorUploadPostMedia{handler: handler})
-	return self
-}
-func (p *PostServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
+func (p *VerificationServiceVerifyEmailCodeArgs) GetRequest() (v *verification.VerifyEmailCodeReq) {
+	if !p.IsSetRequest() {
+		return VerificationServiceVerifyEmailCodeArgs_Request_DEFAULT
 	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
+	return p.Request
 }
 
-type postServiceProcessorGetPostByID struct {
-	handler PostService
+var fieldIDToName_VerificationServiceVerifyEmailCodeArgs = map[int16]string{
+	1: "request",
 }
 
-func (p *postServiceProcessorGetPostByID) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceGetPostByIDArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetPostByID", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *VerificationServiceVerifyEmailCodeArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceGetPostByIDResult{}
-	var retval *post.GetPostByIDResp
-	if retval, err2 = p.handler.GetPostByID(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPostByID: "+err2.Error())
-		oprot.WriteMessageBegin("GetPostByID", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetPostByID", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *VerificationServiceVerifyEmailCodeArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type postServiceProcessorCreatePost struct {
-	handler PostService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceVerifyEmailCodeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *postServiceProcessorCreatePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceCreatePostArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("CreatePost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *VerificationServiceVerifyEmailCodeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := verification.NewVerifyEmailCodeReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Request = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceCreatePostResult{}
-	var retval *post.CreatePostResp
-	if retval, err2 = p.handler.CreatePost(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreatePost: "+err2.Error())
-		oprot.WriteMessageBegin("CreatePost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *VerificationServiceVerifyEmailCodeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyEmailCode_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("CreatePost", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerificationServiceVerifyEmailCodeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Request.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type postServiceProcessorEditPost struct {
-	handler PostService
+func (p *VerificationServiceVerifyEmailCodeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerificationServiceVerifyEmailCodeArgs(%+v)", *p)
+
 }
 
-func (p *postServiceProcessorEditPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceEditPostArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("EditPost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+type VerificationServiceVerifyEmailCodeResult struct {
+	Success *verification.VerifyEmailCodeResp `thrift:"success,0,optional"`
+}
+
+func NewVerificationServiceVerifyEmailCodeResult() *VerificationServiceVerifyEmailCodeResult {
+	return &VerificationServiceVerifyEmailCodeResult{}
+}
+
+func (p *VerificationServiceVerifyEmailCodeResult) InitDefault() {
+}
+
+var VerificationServiceVerifyEmailCodeResult_Success_DEFAULT *verification.VerifyEmailCodeResp
+
+func (p *VerificationServiceVerifyEmailCodeResult) GetSuccess() (v *verification.VerifyEmailCodeResp) {
+	if !p.IsSetSuccess() {
+		return VerificationServiceVerifyEmailCodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_VerificationServiceVerifyEmailCodeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VerificationServiceVerifyEmailCodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerificationServiceVerifyEmailCodeResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceVerifyEmailCodeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VerificationServiceVerifyEmailCodeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := verification.NewVerifyEmailCodeResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VerificationServiceVerifyEmailCodeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VerifyEmailCode_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerificationServiceVerifyEmailCodeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VerificationServiceVerifyEmailCodeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerificationServiceVerifyEmailCodeResult(%+v)", *p)
+
+}
+
+type VerificationServiceMeArgs struct {
+	Request *verification.MeReq `thrift:"request,1"`
+}
+
+func NewVerificationServiceMeArgs() *VerificationServiceMeArgs {
+	return &VerificationServiceMeArgs{}
+}
+
+func (p *VerificationServiceMeArgs) InitDefault() {
+}
+
+var VerificationServiceMeArgs_Request_DEFAULT *verification.MeReq
+
+func (p *VerificationServiceMeArgs) GetRequest() (v *verification.MeReq) {
+	if !p.IsSetRequest() {
+		return VerificationServiceMeArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_VerificationServiceMeArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *VerificationServiceMeArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *VerificationServiceMeArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceMeArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VerificationServiceMeArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := verification.NewMeReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *VerificationServiceMeArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Me_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerificationServiceMeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *VerificationServiceMeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerificationServiceMeArgs(%+v)", *p)
+
+}
+
+type VerificationServiceMeResult struct {
+	Success *verification.MeResp `thrift:"success,0,optional"`
+}
+
+func NewVerificationServiceMeResult() *VerificationServiceMeResult {
+	return &VerificationServiceMeResult{}
+}
+
+func (p *VerificationServiceMeResult) InitDefault() {
+}
+
+var VerificationServiceMeResult_Success_DEFAULT *verification.MeResp
+
+func (p *VerificationServiceMeResult) GetSuccess() (v *verification.MeResp) {
+	if !p.IsSetSuccess() {
+		return VerificationServiceMeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_VerificationServiceMeResult = map[int16]string{
+	0: "success",
+}
+
+func (p *VerificationServiceMeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VerificationServiceMeResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_VerificationServiceMeResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *VerificationServiceMeResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := verification.NewMeResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *VerificationServiceMeResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("Me_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *VerificationServiceMeResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *VerificationServiceMeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VerificationServiceMeResult(%+v)", *p)
+
+}
+
+type PostServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      PostService
+}
+
+func (p *PostServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *PostServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *PostServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewPostServiceProcessor(handler PostService) *PostServiceProcessor {
+	self := &PostServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetPostByID", &postServiceProcessorGetPostByID{handler: handler})
+	self.AddToProcessorMap("BatchGetPosts", &postServiceProcessorBatchGetPosts{handler: handler})
+	self.AddToProcessorMap("CreatePost", &postServiceProcessorCreatePost{handler: handler})
+	self.AddToProcessorMap("EditPost", &postServiceProcessorEditPost{handler: handler})
+	self.AddToProcessorMap("VotePoll", &postServiceProcessorVotePoll{handler: handler})
+	self.AddToProcessorMap("SaveDraft", &postServiceProcessorSaveDraft{handler: handler})
+	self.AddToProcessorMap("UpdateDraft", &postServiceProcessorUpdateDraft{handler: handler})
+	self.AddToProcessorMap("PublishDraft", &postServiceProcessorPublishDraft{handler: handler})
+	self.AddToProcessorMap("GetMyDrafts", &postServiceProcessorGetMyDrafts{handler: handler})
+	self.AddToProcessorMap("GetPostRevisions", &postServiceProcessorGetPostRevisions{handler: handler})
+	self.AddToProcessorMap("DeletePost", &postServiceProcessorDeletePost{handler: handler})
+	self.AddToProcessorMap("GetTrashedPosts", &postServiceProcessorGetTrashedPosts{handler: handler})
+	self.AddToProcessorMap("RestorePost", &postServiceProcessorRestorePost{handler: handler})
+	self.AddToProcessorMap("GetSchoolRecentPosts", &postServiceProcessorGetSchoolRecentPosts{handler: handler})
+	self.AddToProcessorMap("GetCategoryRecentPosts", &postServiceProcessorGetCategoryRecentPosts{handler: handler})
+	self.AddToProcessorMap("GetNearbyPosts", &postServiceProcessorGetNearbyPosts{handler: handler})
+	self.AddToProcessorMap("GetFavoritedPosts", &postServiceProcessorGetFavoritedPosts{handler: handler})
+	self.AddToProcessorMap("GetLikedPosts", &postServiceProcessorGetLikedPosts{handler: handler})
+	self.AddToProcessorMap("GetPostLikers", &postServiceProcessorGetPostLikers{handler: handler})
+	self.AddToProcessorMap("ReportPost", &postServiceProcessorReportPost{handler: handler})
+	self.AddToProcessorMap("GetPendingReports", &postServiceProcessorGetPendingReports{handler: handler})
+	self.AddToProcessorMap("ResolvePostReports", &postServiceProcessorResolvePostReports{handler: handler})
+	self.AddToProcessorMap("GetModerationActions", &postServiceProcessorGetModerationActions{handler: handler})
+	self.AddToProcessorMap("GetMyModerationActions", &postServiceProcessorGetMyModerationActions{handler: handler})
+	self.AddToProcessorMap("PinPost", &postServiceProcessorPinPost{handler: handler})
+	self.AddToProcessorMap("UnpinPost", &postServiceProcessorUnpinPost{handler: handler})
+	self.AddToProcessorMap("GetPostQuotes", &postServiceProcessorGetPostQuotes{handler: handler})
+	self.AddToProcessorMap("GetMentionedPosts", &postServiceProcessorGetMentionedPosts{handler: handler})
+	self.AddToProcessorMap("GetPersonalRecentPosts", &postServiceProcessorGetPersonalRecentPosts{handler: handler})
+	self.AddToProcessorMap("GetFollowingUsersRecentPosts", &postServiceProcessorGetFollowingUsersRecentPosts{handler: handler})
+	self.AddToProcessorMap("GetSchoolHotPosts", &postServiceProcessorGetSchoolHotPosts{handler: handler})
+	self.AddToProcessorMap("GetHotPosts", &postServiceProcessorGetHotPosts{handler: handler})
+	self.AddToProcessorMap("SearchPosts", &postServiceProcessorSearchPosts{handler: handler})
+	self.AddToProcessorMap("LikePost", &postServiceProcessorLikePost{handler: handler})
+	self.AddToProcessorMap("UnlikePost", &postServiceProcessorUnlikePost{handler: handler})
+	self.AddToProcessorMap("FavPost", &postServiceProcessorFavPost{handler: handler})
+	self.AddToProcessorMap("UnfavPost", &postServiceProcessorUnfavPost{handler: handler})
+	self.AddToProcessorMap("UploadPostMedia", &postServiceProcessorUploadPostMedia{handler: handler})
+	return self
+}
+func (p *PostServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type postServiceProcessorGetPostByID struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetPostByID) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetPostByIDArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPostByID", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetPostByIDResult{}
+	var retval *post.GetPostByIDResp
+	if retval, err2 = p.handler.GetPostByID(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPostByID: "+err2.Error())
+		oprot.WriteMessageBegin("GetPostByID", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPostByID", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorBatchGetPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorBatchGetPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceBatchGetPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("BatchGetPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceBatchGetPostsResult{}
+	var retval *post.BatchGetPostsResp
+	if retval, err2 = p.handler.BatchGetPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BatchGetPosts: "+err2.Error())
+		oprot.WriteMessageBegin("BatchGetPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("BatchGetPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorCreatePost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorCreatePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceCreatePostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreatePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceCreatePostResult{}
+	var retval *post.CreatePostResp
+	if retval, err2 = p.handler.CreatePost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreatePost: "+err2.Error())
+		oprot.WriteMessageBegin("CreatePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("CreatePost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorEditPost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorEditPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceEditPostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("EditPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceEditPostResult{}
+	var retval *post.EditPostResp
+	if retval, err2 = p.handler.EditPost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing EditPost: "+err2.Error())
+		oprot.WriteMessageBegin("EditPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("EditPost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorVotePoll struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorVotePoll) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceVotePollArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("VotePoll", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceVotePollResult{}
+	var retval *post.VotePollResp
+	if retval, err2 = p.handler.VotePoll(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing VotePoll: "+err2.Error())
+		oprot.WriteMessageBegin("VotePoll", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("VotePoll", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorSaveDraft struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorSaveDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceSaveDraftArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SaveDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceSaveDraftResult{}
+	var retval *post.SaveDraftResp
+	if retval, err2 = p.handler.SaveDraft(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SaveDraft: "+err2.Error())
+		oprot.WriteMessageBegin("SaveDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SaveDraft", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorUpdateDraft struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorUpdateDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceUpdateDraftArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UpdateDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceUpdateDraftResult{}
+	var retval *post.UpdateDraftResp
+	if retval, err2 = p.handler.UpdateDraft(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateDraft: "+err2.Error())
+		oprot.WriteMessageBegin("UpdateDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UpdateDraft", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorPublishDraft struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorPublishDraft) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServicePublishDraftArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PublishDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServicePublishDraftResult{}
+	var retval *post.PublishDraftResp
+	if retval, err2 = p.handler.PublishDraft(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PublishDraft: "+err2.Error())
+		oprot.WriteMessageBegin("PublishDraft", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PublishDraft", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetMyDrafts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetMyDrafts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetMyDraftsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetMyDrafts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetMyDraftsResult{}
+	var retval *post.GetMyDraftsResp
+	if retval, err2 = p.handler.GetMyDrafts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetMyDrafts: "+err2.Error())
+		oprot.WriteMessageBegin("GetMyDrafts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetMyDrafts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetPostRevisions struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetPostRevisions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetPostRevisionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPostRevisions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetPostRevisionsResult{}
+	var retval *post.GetPostRevisionsResp
+	if retval, err2 = p.handler.GetPostRevisions(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPostRevisions: "+err2.Error())
+		oprot.WriteMessageBegin("GetPostRevisions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPostRevisions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorDeletePost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorDeletePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceDeletePostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeletePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceDeletePostResult{}
+	var retval *post.DeletePostResp
+	if retval, err2 = p.handler.DeletePost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeletePost: "+err2.Error())
+		oprot.WriteMessageBegin("DeletePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeletePost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetTrashedPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetTrashedPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetTrashedPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTrashedPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetTrashedPostsResult{}
+	var retval *post.GetTrashedPostsResp
+	if retval, err2 = p.handler.GetTrashedPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTrashedPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetTrashedPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTrashedPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorRestorePost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorRestorePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceRestorePostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("RestorePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceRestorePostResult{}
+	var retval *post.RestorePostResp
+	if retval, err2 = p.handler.RestorePost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RestorePost: "+err2.Error())
+		oprot.WriteMessageBegin("RestorePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("RestorePost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetSchoolRecentPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetSchoolRecentPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetSchoolRecentPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetSchoolRecentPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetSchoolRecentPostsResult{}
+	var retval *post.GetSchoolRecentPostsResp
+	if retval, err2 = p.handler.GetSchoolRecentPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSchoolRecentPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetSchoolRecentPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetSchoolRecentPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetCategoryRecentPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetCategoryRecentPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetCategoryRecentPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCategoryRecentPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetCategoryRecentPostsResult{}
+	var retval *post.GetCategoryRecentPostsResp
+	if retval, err2 = p.handler.GetCategoryRecentPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCategoryRecentPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetCategoryRecentPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetCategoryRecentPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetNearbyPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetNearbyPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetNearbyPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetNearbyPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetNearbyPostsResult{}
+	var retval *post.GetNearbyPostsResp
+	if retval, err2 = p.handler.GetNearbyPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetNearbyPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetNearbyPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetNearbyPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetFavoritedPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetFavoritedPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetFavoritedPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFavoritedPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetFavoritedPostsResult{}
+	var retval *post.GetFavoritedPostsResp
+	if retval, err2 = p.handler.GetFavoritedPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFavoritedPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetFavoritedPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFavoritedPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetLikedPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetLikedPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetLikedPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetLikedPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetLikedPostsResult{}
+	var retval *post.GetLikedPostsResp
+	if retval, err2 = p.handler.GetLikedPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetLikedPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetLikedPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetLikedPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetPostLikers struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetPostLikers) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetPostLikersArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPostLikers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetPostLikersResult{}
+	var retval *post.GetPostLikersResp
+	if retval, err2 = p.handler.GetPostLikers(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPostLikers: "+err2.Error())
+		oprot.WriteMessageBegin("GetPostLikers", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPostLikers", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorReportPost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorReportPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceReportPostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ReportPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceReportPostResult{}
+	var retval *post.ReportPostResp
+	if retval, err2 = p.handler.ReportPost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ReportPost: "+err2.Error())
+		oprot.WriteMessageBegin("ReportPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ReportPost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetPendingReports struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetPendingReports) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetPendingReportsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPendingReports", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetPendingReportsResult{}
+	var retval *post.GetPendingReportsResp
+	if retval, err2 = p.handler.GetPendingReports(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPendingReports: "+err2.Error())
+		oprot.WriteMessageBegin("GetPendingReports", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPendingReports", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorResolvePostReports struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorResolvePostReports) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceResolvePostReportsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("ResolvePostReports", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceResolvePostReportsResult{}
+	var retval *post.ResolvePostReportsResp
+	if retval, err2 = p.handler.ResolvePostReports(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResolvePostReports: "+err2.Error())
+		oprot.WriteMessageBegin("ResolvePostReports", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("ResolvePostReports", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetModerationActions struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetModerationActions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetModerationActionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetModerationActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetModerationActionsResult{}
+	var retval *post.GetModerationActionsResp
+	if retval, err2 = p.handler.GetModerationActions(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetModerationActions: "+err2.Error())
+		oprot.WriteMessageBegin("GetModerationActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetModerationActions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetMyModerationActions struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetMyModerationActions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetMyModerationActionsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetMyModerationActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetMyModerationActionsResult{}
+	var retval *post.GetModerationActionsResp
+	if retval, err2 = p.handler.GetMyModerationActions(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetMyModerationActions: "+err2.Error())
+		oprot.WriteMessageBegin("GetMyModerationActions", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetMyModerationActions", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorPinPost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorPinPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServicePinPostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("PinPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServicePinPostResult{}
+	var retval *post.PinPostResp
+	if retval, err2 = p.handler.PinPost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PinPost: "+err2.Error())
+		oprot.WriteMessageBegin("PinPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("PinPost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorUnpinPost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorUnpinPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceUnpinPostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UnpinPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceUnpinPostResult{}
+	var retval *post.UnpinPostResp
+	if retval, err2 = p.handler.UnpinPost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UnpinPost: "+err2.Error())
+		oprot.WriteMessageBegin("UnpinPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UnpinPost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetPostQuotes struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetPostQuotes) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetPostQuotesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPostQuotes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetPostQuotesResult{}
+	var retval *post.GetPostQuotesResp
+	if retval, err2 = p.handler.GetPostQuotes(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPostQuotes: "+err2.Error())
+		oprot.WriteMessageBegin("GetPostQuotes", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPostQuotes", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetMentionedPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetMentionedPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetMentionedPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetMentionedPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetMentionedPostsResult{}
+	var retval *post.GetMentionedPostsResp
+	if retval, err2 = p.handler.GetMentionedPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetMentionedPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetMentionedPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetMentionedPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetPersonalRecentPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetPersonalRecentPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetPersonalRecentPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPersonalRecentPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetPersonalRecentPostsResult{}
+	var retval *post.GetPersonalRecentPostsReq
+	if retval, err2 = p.handler.GetPersonalRecentPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPersonalRecentPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetPersonalRecentPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPersonalRecentPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetFollowingUsersRecentPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetFollowingUsersRecentPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetFollowingUsersRecentPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetFollowingUsersRecentPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetFollowingUsersRecentPostsResult{}
+	var retval *post.GetFollowingUsersRecentPostsResp
+	if retval, err2 = p.handler.GetFollowingUsersRecentPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetFollowingUsersRecentPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetFollowingUsersRecentPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetFollowingUsersRecentPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetSchoolHotPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetSchoolHotPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetSchoolHotPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetSchoolHotPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetSchoolHotPostsResult{}
+	var retval *post.GetSchoolHotPostsResp
+	if retval, err2 = p.handler.GetSchoolHotPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSchoolHotPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetSchoolHotPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetSchoolHotPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorGetHotPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorGetHotPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceGetHotPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetHotPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceGetHotPostsResult{}
+	var retval *post.GetHotPostsResp
+	if retval, err2 = p.handler.GetHotPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetHotPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetHotPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetHotPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorSearchPosts struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorSearchPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceSearchPostsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("SearchPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceSearchPostsResult{}
+	var retval *post.SearchPostsResp
+	if retval, err2 = p.handler.SearchPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SearchPosts: "+err2.Error())
+		oprot.WriteMessageBegin("SearchPosts", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("SearchPosts", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorLikePost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorLikePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceLikePostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("LikePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceLikePostResult{}
+	var retval *post.LikePostReq
+	if retval, err2 = p.handler.LikePost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LikePost: "+err2.Error())
+		oprot.WriteMessageBegin("LikePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("LikePost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorUnlikePost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorUnlikePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceUnlikePostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UnlikePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceUnlikePostResult{}
+	var retval *post.UnlikePostReq
+	if retval, err2 = p.handler.UnlikePost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UnlikePost: "+err2.Error())
+		oprot.WriteMessageBegin("UnlikePost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UnlikePost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorFavPost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorFavPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceFavPostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("FavPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceFavPostResult{}
+	var retval *post.FavPostReq
+	if retval, err2 = p.handler.FavPost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FavPost: "+err2.Error())
+		oprot.WriteMessageBegin("FavPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("FavPost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorUnfavPost struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorUnfavPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceUnfavPostArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UnfavPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := PostServiceUnfavPostResult{}
+	var retval *post.UnfavPostReq
+	if retval, err2 = p.handler.UnfavPost(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UnfavPost: "+err2.Error())
+		oprot.WriteMessageBegin("UnfavPost", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UnfavPost", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type postServiceProcessorUploadPostMedia struct {
+	handler PostService
+}
+
+func (p *postServiceProcessorUploadPostMedia) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := PostServiceUploadPostMediaArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UploadPostMedia", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := PostServiceEditPostResult{}
-	var retval *post.EditPostResp
-	if retval, err2 = p.handler.EditPost(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing EditPost: "+err2.Error())
-		oprot.WriteMessageBegin("EditPost", thrift.EXCEPTION, seqId)
+	result := PostServiceUploadPostMediaResult{}
+	var retval *post.UploadPostMediaResp
+	if retval, err2 = p.handler.UploadPostMedia(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadPostMedia: "+err2.Error())
+		oprot.WriteMessageBegin("UploadPostMedia", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -5940,437 +8801,10637 @@ func (p *postServiceProcessorEditPost) Process(ctx context.Context, seqId int32,
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("EditPost", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if err2 = oprot.WriteMessageBegin("UploadPostMedia", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type PostServiceGetPostByIDArgs struct {
+	Request *post.GetPostByIDReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetPostByIDArgs() *PostServiceGetPostByIDArgs {
+	return &PostServiceGetPostByIDArgs{}
+}
+
+func (p *PostServiceGetPostByIDArgs) InitDefault() {
+}
+
+var PostServiceGetPostByIDArgs_Request_DEFAULT *post.GetPostByIDReq
+
+func (p *PostServiceGetPostByIDArgs) GetRequest() (v *post.GetPostByIDReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetPostByIDArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetPostByIDArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetPostByIDArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetPostByIDArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostByIDArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostByIDArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetPostByIDReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetPostByIDArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPostByID_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetPostByIDArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPostByIDArgs(%+v)", *p)
+
+}
+
+type PostServiceGetPostByIDResult struct {
+	Success *post.GetPostByIDResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetPostByIDResult() *PostServiceGetPostByIDResult {
+	return &PostServiceGetPostByIDResult{}
+}
+
+func (p *PostServiceGetPostByIDResult) InitDefault() {
+}
+
+var PostServiceGetPostByIDResult_Success_DEFAULT *post.GetPostByIDResp
+
+func (p *PostServiceGetPostByIDResult) GetSuccess() (v *post.GetPostByIDResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetPostByIDResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetPostByIDResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetPostByIDResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetPostByIDResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostByIDResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostByIDResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetPostByIDResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetPostByIDResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPostByID_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostByIDResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetPostByIDResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPostByIDResult(%+v)", *p)
+
+}
+
+type PostServiceBatchGetPostsArgs struct {
+	Request *post.BatchGetPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceBatchGetPostsArgs() *PostServiceBatchGetPostsArgs {
+	return &PostServiceBatchGetPostsArgs{}
+}
+
+func (p *PostServiceBatchGetPostsArgs) InitDefault() {
+}
+
+var PostServiceBatchGetPostsArgs_Request_DEFAULT *post.BatchGetPostsReq
+
+func (p *PostServiceBatchGetPostsArgs) GetRequest() (v *post.BatchGetPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceBatchGetPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceBatchGetPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceBatchGetPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceBatchGetPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceBatchGetPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceBatchGetPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewBatchGetPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceBatchGetPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceBatchGetPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceBatchGetPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceBatchGetPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceBatchGetPostsResult struct {
+	Success *post.BatchGetPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceBatchGetPostsResult() *PostServiceBatchGetPostsResult {
+	return &PostServiceBatchGetPostsResult{}
+}
+
+func (p *PostServiceBatchGetPostsResult) InitDefault() {
+}
+
+var PostServiceBatchGetPostsResult_Success_DEFAULT *post.BatchGetPostsResp
+
+func (p *PostServiceBatchGetPostsResult) GetSuccess() (v *post.BatchGetPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceBatchGetPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceBatchGetPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceBatchGetPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceBatchGetPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceBatchGetPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceBatchGetPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewBatchGetPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceBatchGetPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("BatchGetPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceBatchGetPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceBatchGetPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceBatchGetPostsResult(%+v)", *p)
+
+}
+
+type PostServiceCreatePostArgs struct {
+	Request *post.CreatePostReq `thrift:"request,1"`
+}
+
+func NewPostServiceCreatePostArgs() *PostServiceCreatePostArgs {
+	return &PostServiceCreatePostArgs{}
+}
+
+func (p *PostServiceCreatePostArgs) InitDefault() {
+}
+
+var PostServiceCreatePostArgs_Request_DEFAULT *post.CreatePostReq
+
+func (p *PostServiceCreatePostArgs) GetRequest() (v *post.CreatePostReq) {
+	if !p.IsSetRequest() {
+		return PostServiceCreatePostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceCreatePostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceCreatePostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceCreatePostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceCreatePostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceCreatePostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewCreatePostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceCreatePostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreatePost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceCreatePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceCreatePostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceCreatePostArgs(%+v)", *p)
+
+}
+
+type PostServiceCreatePostResult struct {
+	Success *post.CreatePostResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceCreatePostResult() *PostServiceCreatePostResult {
+	return &PostServiceCreatePostResult{}
+}
+
+func (p *PostServiceCreatePostResult) InitDefault() {
+}
+
+var PostServiceCreatePostResult_Success_DEFAULT *post.CreatePostResp
+
+func (p *PostServiceCreatePostResult) GetSuccess() (v *post.CreatePostResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceCreatePostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceCreatePostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceCreatePostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceCreatePostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceCreatePostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceCreatePostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewCreatePostResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceCreatePostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("CreatePost_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceCreatePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceCreatePostResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceCreatePostResult(%+v)", *p)
+
+}
+
+type PostServiceEditPostArgs struct {
+	Request *post.EditPostReq `thrift:"request,1"`
+}
+
+func NewPostServiceEditPostArgs() *PostServiceEditPostArgs {
+	return &PostServiceEditPostArgs{}
+}
+
+func (p *PostServiceEditPostArgs) InitDefault() {
+}
+
+var PostServiceEditPostArgs_Request_DEFAULT *post.EditPostReq
+
+func (p *PostServiceEditPostArgs) GetRequest() (v *post.EditPostReq) {
+	if !p.IsSetRequest() {
+		return PostServiceEditPostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceEditPostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceEditPostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceEditPostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceEditPostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceEditPostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewEditPostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceEditPostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EditPost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceEditPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceEditPostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceEditPostArgs(%+v)", *p)
+
+}
+
+type PostServiceEditPostResult struct {
+	Success *post.EditPostResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceEditPostResult() *PostServiceEditPostResult {
+	return &PostServiceEditPostResult{}
+}
+
+func (p *PostServiceEditPostResult) InitDefault() {
+}
+
+var PostServiceEditPostResult_Success_DEFAULT *post.EditPostResp
+
+func (p *PostServiceEditPostResult) GetSuccess() (v *post.EditPostResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceEditPostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceEditPostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceEditPostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceEditPostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceEditPostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceEditPostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewEditPostResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceEditPostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("EditPost_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceEditPostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceEditPostResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceEditPostResult(%+v)", *p)
+
+}
+
+type PostServiceVotePollArgs struct {
+	Request *post.VotePollReq `thrift:"request,1"`
+}
+
+func NewPostServiceVotePollArgs() *PostServiceVotePollArgs {
+	return &PostServiceVotePollArgs{}
+}
+
+func (p *PostServiceVotePollArgs) InitDefault() {
+}
+
+var PostServiceVotePollArgs_Request_DEFAULT *post.VotePollReq
+
+func (p *PostServiceVotePollArgs) GetRequest() (v *post.VotePollReq) {
+	if !p.IsSetRequest() {
+		return PostServiceVotePollArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceVotePollArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceVotePollArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceVotePollArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceVotePollArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceVotePollArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewVotePollReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceVotePollArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VotePoll_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceVotePollArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceVotePollArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceVotePollArgs(%+v)", *p)
+
+}
+
+type PostServiceVotePollResult struct {
+	Success *post.VotePollResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceVotePollResult() *PostServiceVotePollResult {
+	return &PostServiceVotePollResult{}
+}
+
+func (p *PostServiceVotePollResult) InitDefault() {
+}
+
+var PostServiceVotePollResult_Success_DEFAULT *post.VotePollResp
+
+func (p *PostServiceVotePollResult) GetSuccess() (v *post.VotePollResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceVotePollResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceVotePollResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceVotePollResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceVotePollResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceVotePollResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceVotePollResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewVotePollResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceVotePollResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("VotePoll_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceVotePollResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceVotePollResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceVotePollResult(%+v)", *p)
+
+}
+
+type PostServiceSaveDraftArgs struct {
+	Request *post.SaveDraftReq `thrift:"request,1"`
+}
+
+func NewPostServiceSaveDraftArgs() *PostServiceSaveDraftArgs {
+	return &PostServiceSaveDraftArgs{}
+}
+
+func (p *PostServiceSaveDraftArgs) InitDefault() {
+}
+
+var PostServiceSaveDraftArgs_Request_DEFAULT *post.SaveDraftReq
+
+func (p *PostServiceSaveDraftArgs) GetRequest() (v *post.SaveDraftReq) {
+	if !p.IsSetRequest() {
+		return PostServiceSaveDraftArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceSaveDraftArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceSaveDraftArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceSaveDraftArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceSaveDraftArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceSaveDraftArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewSaveDraftReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceSaveDraftArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SaveDraft_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceSaveDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceSaveDraftArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceSaveDraftArgs(%+v)", *p)
+
+}
+
+type PostServiceSaveDraftResult struct {
+	Success *post.SaveDraftResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceSaveDraftResult() *PostServiceSaveDraftResult {
+	return &PostServiceSaveDraftResult{}
+}
+
+func (p *PostServiceSaveDraftResult) InitDefault() {
+}
+
+var PostServiceSaveDraftResult_Success_DEFAULT *post.SaveDraftResp
+
+func (p *PostServiceSaveDraftResult) GetSuccess() (v *post.SaveDraftResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceSaveDraftResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceSaveDraftResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceSaveDraftResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceSaveDraftResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceSaveDraftResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceSaveDraftResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewSaveDraftResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceSaveDraftResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SaveDraft_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceSaveDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceSaveDraftResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceSaveDraftResult(%+v)", *p)
+
+}
+
+type PostServiceUpdateDraftArgs struct {
+	Request *post.UpdateDraftReq `thrift:"request,1"`
+}
+
+func NewPostServiceUpdateDraftArgs() *PostServiceUpdateDraftArgs {
+	return &PostServiceUpdateDraftArgs{}
+}
+
+func (p *PostServiceUpdateDraftArgs) InitDefault() {
+}
+
+var PostServiceUpdateDraftArgs_Request_DEFAULT *post.UpdateDraftReq
+
+func (p *PostServiceUpdateDraftArgs) GetRequest() (v *post.UpdateDraftReq) {
+	if !p.IsSetRequest() {
+		return PostServiceUpdateDraftArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceUpdateDraftArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceUpdateDraftArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceUpdateDraftArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUpdateDraftArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceUpdateDraftArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewUpdateDraftReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceUpdateDraftArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateDraft_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceUpdateDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceUpdateDraftArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceUpdateDraftArgs(%+v)", *p)
+
+}
+
+type PostServiceUpdateDraftResult struct {
+	Success *post.UpdateDraftResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceUpdateDraftResult() *PostServiceUpdateDraftResult {
+	return &PostServiceUpdateDraftResult{}
+}
+
+func (p *PostServiceUpdateDraftResult) InitDefault() {
+}
+
+var PostServiceUpdateDraftResult_Success_DEFAULT *post.UpdateDraftResp
+
+func (p *PostServiceUpdateDraftResult) GetSuccess() (v *post.UpdateDraftResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceUpdateDraftResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceUpdateDraftResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceUpdateDraftResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceUpdateDraftResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUpdateDraftResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceUpdateDraftResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewUpdateDraftResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceUpdateDraftResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UpdateDraft_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceUpdateDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceUpdateDraftResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceUpdateDraftResult(%+v)", *p)
+
+}
+
+type PostServicePublishDraftArgs struct {
+	Request *post.PublishDraftReq `thrift:"request,1"`
+}
+
+func NewPostServicePublishDraftArgs() *PostServicePublishDraftArgs {
+	return &PostServicePublishDraftArgs{}
+}
+
+func (p *PostServicePublishDraftArgs) InitDefault() {
+}
+
+var PostServicePublishDraftArgs_Request_DEFAULT *post.PublishDraftReq
+
+func (p *PostServicePublishDraftArgs) GetRequest() (v *post.PublishDraftReq) {
+	if !p.IsSetRequest() {
+		return PostServicePublishDraftArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServicePublishDraftArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServicePublishDraftArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServicePublishDraftArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServicePublishDraftArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServicePublishDraftArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewPublishDraftReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServicePublishDraftArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishDraft_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServicePublishDraftArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServicePublishDraftArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServicePublishDraftArgs(%+v)", *p)
+
+}
+
+type PostServicePublishDraftResult struct {
+	Success *post.PublishDraftResp `thrift:"success,0,optional"`
+}
+
+func NewPostServicePublishDraftResult() *PostServicePublishDraftResult {
+	return &PostServicePublishDraftResult{}
+}
+
+func (p *PostServicePublishDraftResult) InitDefault() {
+}
+
+var PostServicePublishDraftResult_Success_DEFAULT *post.PublishDraftResp
+
+func (p *PostServicePublishDraftResult) GetSuccess() (v *post.PublishDraftResp) {
+	if !p.IsSetSuccess() {
+		return PostServicePublishDraftResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServicePublishDraftResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServicePublishDraftResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServicePublishDraftResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServicePublishDraftResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServicePublishDraftResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewPublishDraftResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServicePublishDraftResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PublishDraft_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServicePublishDraftResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServicePublishDraftResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServicePublishDraftResult(%+v)", *p)
+
+}
+
+type PostServiceGetMyDraftsArgs struct {
+	Request *post.GetMyDraftsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetMyDraftsArgs() *PostServiceGetMyDraftsArgs {
+	return &PostServiceGetMyDraftsArgs{}
+}
+
+func (p *PostServiceGetMyDraftsArgs) InitDefault() {
+}
+
+var PostServiceGetMyDraftsArgs_Request_DEFAULT *post.GetMyDraftsReq
+
+func (p *PostServiceGetMyDraftsArgs) GetRequest() (v *post.GetMyDraftsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetMyDraftsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetMyDraftsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetMyDraftsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetMyDraftsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetMyDraftsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMyDraftsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetMyDraftsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetMyDraftsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMyDrafts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMyDraftsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetMyDraftsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetMyDraftsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetMyDraftsResult struct {
+	Success *post.GetMyDraftsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetMyDraftsResult() *PostServiceGetMyDraftsResult {
+	return &PostServiceGetMyDraftsResult{}
+}
+
+func (p *PostServiceGetMyDraftsResult) InitDefault() {
+}
+
+var PostServiceGetMyDraftsResult_Success_DEFAULT *post.GetMyDraftsResp
+
+func (p *PostServiceGetMyDraftsResult) GetSuccess() (v *post.GetMyDraftsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetMyDraftsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetMyDraftsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetMyDraftsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetMyDraftsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetMyDraftsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMyDraftsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetMyDraftsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetMyDraftsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMyDrafts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMyDraftsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetMyDraftsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetMyDraftsResult(%+v)", *p)
+
+}
+
+type PostServiceGetPostRevisionsArgs struct {
+	Request *post.GetPostRevisionsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetPostRevisionsArgs() *PostServiceGetPostRevisionsArgs {
+	return &PostServiceGetPostRevisionsArgs{}
+}
+
+func (p *PostServiceGetPostRevisionsArgs) InitDefault() {
+}
+
+var PostServiceGetPostRevisionsArgs_Request_DEFAULT *post.GetPostRevisionsReq
+
+func (p *PostServiceGetPostRevisionsArgs) GetRequest() (v *post.GetPostRevisionsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetPostRevisionsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetPostRevisionsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetPostRevisionsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetPostRevisionsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostRevisionsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostRevisionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetPostRevisionsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetPostRevisionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPostRevisions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostRevisionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetPostRevisionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPostRevisionsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetPostRevisionsResult struct {
+	Success *post.GetPostRevisionsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetPostRevisionsResult() *PostServiceGetPostRevisionsResult {
+	return &PostServiceGetPostRevisionsResult{}
+}
+
+func (p *PostServiceGetPostRevisionsResult) InitDefault() {
+}
+
+var PostServiceGetPostRevisionsResult_Success_DEFAULT *post.GetPostRevisionsResp
+
+func (p *PostServiceGetPostRevisionsResult) GetSuccess() (v *post.GetPostRevisionsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetPostRevisionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetPostRevisionsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetPostRevisionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetPostRevisionsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostRevisionsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostRevisionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetPostRevisionsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetPostRevisionsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPostRevisions_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostRevisionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetPostRevisionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPostRevisionsResult(%+v)", *p)
+
+}
+
+type PostServiceDeletePostArgs struct {
+	Request *post.DeletePostReq `thrift:"request,1"`
+}
+
+func NewPostServiceDeletePostArgs() *PostServiceDeletePostArgs {
+	return &PostServiceDeletePostArgs{}
+}
+
+func (p *PostServiceDeletePostArgs) InitDefault() {
+}
+
+var PostServiceDeletePostArgs_Request_DEFAULT *post.DeletePostReq
+
+func (p *PostServiceDeletePostArgs) GetRequest() (v *post.DeletePostReq) {
+	if !p.IsSetRequest() {
+		return PostServiceDeletePostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceDeletePostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceDeletePostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceDeletePostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceDeletePostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceDeletePostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewDeletePostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceDeletePostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeletePost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceDeletePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceDeletePostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceDeletePostArgs(%+v)", *p)
+
+}
+
+type PostServiceDeletePostResult struct {
+	Success *post.DeletePostResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceDeletePostResult() *PostServiceDeletePostResult {
+	return &PostServiceDeletePostResult{}
+}
+
+func (p *PostServiceDeletePostResult) InitDefault() {
+}
+
+var PostServiceDeletePostResult_Success_DEFAULT *post.DeletePostResp
+
+func (p *PostServiceDeletePostResult) GetSuccess() (v *post.DeletePostResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceDeletePostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceDeletePostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceDeletePostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceDeletePostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceDeletePostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceDeletePostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewDeletePostResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceDeletePostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("DeletePost_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceDeletePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceDeletePostResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceDeletePostResult(%+v)", *p)
+
+}
+
+type PostServiceGetTrashedPostsArgs struct {
+	Request *post.GetTrashedPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetTrashedPostsArgs() *PostServiceGetTrashedPostsArgs {
+	return &PostServiceGetTrashedPostsArgs{}
+}
+
+func (p *PostServiceGetTrashedPostsArgs) InitDefault() {
+}
+
+var PostServiceGetTrashedPostsArgs_Request_DEFAULT *post.GetTrashedPostsReq
+
+func (p *PostServiceGetTrashedPostsArgs) GetRequest() (v *post.GetTrashedPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetTrashedPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetTrashedPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetTrashedPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetTrashedPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetTrashedPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetTrashedPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetTrashedPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetTrashedPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTrashedPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetTrashedPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetTrashedPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetTrashedPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetTrashedPostsResult struct {
+	Success *post.GetTrashedPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetTrashedPostsResult() *PostServiceGetTrashedPostsResult {
+	return &PostServiceGetTrashedPostsResult{}
+}
+
+func (p *PostServiceGetTrashedPostsResult) InitDefault() {
+}
+
+var PostServiceGetTrashedPostsResult_Success_DEFAULT *post.GetTrashedPostsResp
+
+func (p *PostServiceGetTrashedPostsResult) GetSuccess() (v *post.GetTrashedPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetTrashedPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetTrashedPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetTrashedPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetTrashedPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetTrashedPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetTrashedPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetTrashedPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetTrashedPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetTrashedPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetTrashedPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetTrashedPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetTrashedPostsResult(%+v)", *p)
+
+}
+
+type PostServiceRestorePostArgs struct {
+	Request *post.RestorePostReq `thrift:"request,1"`
+}
+
+func NewPostServiceRestorePostArgs() *PostServiceRestorePostArgs {
+	return &PostServiceRestorePostArgs{}
+}
+
+func (p *PostServiceRestorePostArgs) InitDefault() {
+}
+
+var PostServiceRestorePostArgs_Request_DEFAULT *post.RestorePostReq
+
+func (p *PostServiceRestorePostArgs) GetRequest() (v *post.RestorePostReq) {
+	if !p.IsSetRequest() {
+		return PostServiceRestorePostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceRestorePostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceRestorePostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceRestorePostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceRestorePostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceRestorePostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewRestorePostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceRestorePostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RestorePost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceRestorePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceRestorePostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceRestorePostArgs(%+v)", *p)
+
+}
+
+type PostServiceRestorePostResult struct {
+	Success *post.RestorePostResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceRestorePostResult() *PostServiceRestorePostResult {
+	return &PostServiceRestorePostResult{}
+}
+
+func (p *PostServiceRestorePostResult) InitDefault() {
+}
+
+var PostServiceRestorePostResult_Success_DEFAULT *post.RestorePostResp
+
+func (p *PostServiceRestorePostResult) GetSuccess() (v *post.RestorePostResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceRestorePostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceRestorePostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceRestorePostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceRestorePostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceRestorePostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceRestorePostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewRestorePostResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceRestorePostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("RestorePost_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceRestorePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceRestorePostResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceRestorePostResult(%+v)", *p)
+
+}
+
+type PostServiceGetSchoolRecentPostsArgs struct {
+	Request *post.GetSchoolRecentPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetSchoolRecentPostsArgs() *PostServiceGetSchoolRecentPostsArgs {
+	return &PostServiceGetSchoolRecentPostsArgs{}
+}
+
+func (p *PostServiceGetSchoolRecentPostsArgs) InitDefault() {
+}
+
+var PostServiceGetSchoolRecentPostsArgs_Request_DEFAULT *post.GetSchoolRecentPostsReq
+
+func (p *PostServiceGetSchoolRecentPostsArgs) GetRequest() (v *post.GetSchoolRecentPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetSchoolRecentPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetSchoolRecentPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetSchoolRecentPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetSchoolRecentPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetSchoolRecentPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolRecentPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetSchoolRecentPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetSchoolRecentPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetSchoolRecentPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolRecentPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolRecentPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetSchoolRecentPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetSchoolRecentPostsResult struct {
+	Success *post.GetSchoolRecentPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetSchoolRecentPostsResult() *PostServiceGetSchoolRecentPostsResult {
+	return &PostServiceGetSchoolRecentPostsResult{}
+}
+
+func (p *PostServiceGetSchoolRecentPostsResult) InitDefault() {
+}
+
+var PostServiceGetSchoolRecentPostsResult_Success_DEFAULT *post.GetSchoolRecentPostsResp
+
+func (p *PostServiceGetSchoolRecentPostsResult) GetSuccess() (v *post.GetSchoolRecentPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetSchoolRecentPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetSchoolRecentPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetSchoolRecentPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetSchoolRecentPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetSchoolRecentPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolRecentPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetSchoolRecentPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetSchoolRecentPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetSchoolRecentPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolRecentPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolRecentPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetSchoolRecentPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetCategoryRecentPostsArgs struct {
+	Request *post.GetCategoryRecentPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetCategoryRecentPostsArgs() *PostServiceGetCategoryRecentPostsArgs {
+	return &PostServiceGetCategoryRecentPostsArgs{}
+}
+
+func (p *PostServiceGetCategoryRecentPostsArgs) InitDefault() {
+}
+
+var PostServiceGetCategoryRecentPostsArgs_Request_DEFAULT *post.GetCategoryRecentPostsReq
+
+func (p *PostServiceGetCategoryRecentPostsArgs) GetRequest() (v *post.GetCategoryRecentPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetCategoryRecentPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetCategoryRecentPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetCategoryRecentPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetCategoryRecentPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetCategoryRecentPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetCategoryRecentPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetCategoryRecentPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetCategoryRecentPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCategoryRecentPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetCategoryRecentPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetCategoryRecentPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetCategoryRecentPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetCategoryRecentPostsResult struct {
+	Success *post.GetCategoryRecentPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetCategoryRecentPostsResult() *PostServiceGetCategoryRecentPostsResult {
+	return &PostServiceGetCategoryRecentPostsResult{}
+}
+
+func (p *PostServiceGetCategoryRecentPostsResult) InitDefault() {
+}
+
+var PostServiceGetCategoryRecentPostsResult_Success_DEFAULT *post.GetCategoryRecentPostsResp
+
+func (p *PostServiceGetCategoryRecentPostsResult) GetSuccess() (v *post.GetCategoryRecentPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetCategoryRecentPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetCategoryRecentPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetCategoryRecentPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetCategoryRecentPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetCategoryRecentPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetCategoryRecentPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetCategoryRecentPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetCategoryRecentPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetCategoryRecentPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetCategoryRecentPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetCategoryRecentPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetCategoryRecentPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetNearbyPostsArgs struct {
+	Request *post.GetNearbyPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetNearbyPostsArgs() *PostServiceGetNearbyPostsArgs {
+	return &PostServiceGetNearbyPostsArgs{}
+}
+
+func (p *PostServiceGetNearbyPostsArgs) InitDefault() {
+}
+
+var PostServiceGetNearbyPostsArgs_Request_DEFAULT *post.GetNearbyPostsReq
+
+func (p *PostServiceGetNearbyPostsArgs) GetRequest() (v *post.GetNearbyPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetNearbyPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetNearbyPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetNearbyPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetNearbyPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetNearbyPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetNearbyPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetNearbyPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetNearbyPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNearbyPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetNearbyPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetNearbyPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetNearbyPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetNearbyPostsResult struct {
+	Success *post.GetNearbyPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetNearbyPostsResult() *PostServiceGetNearbyPostsResult {
+	return &PostServiceGetNearbyPostsResult{}
+}
+
+func (p *PostServiceGetNearbyPostsResult) InitDefault() {
+}
+
+var PostServiceGetNearbyPostsResult_Success_DEFAULT *post.GetNearbyPostsResp
+
+func (p *PostServiceGetNearbyPostsResult) GetSuccess() (v *post.GetNearbyPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetNearbyPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetNearbyPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetNearbyPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetNearbyPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetNearbyPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetNearbyPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetNearbyPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetNearbyPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetNearbyPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetNearbyPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetNearbyPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetNearbyPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetFavoritedPostsArgs struct {
+	Request *post.GetFavoritedPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetFavoritedPostsArgs() *PostServiceGetFavoritedPostsArgs {
+	return &PostServiceGetFavoritedPostsArgs{}
+}
+
+func (p *PostServiceGetFavoritedPostsArgs) InitDefault() {
+}
+
+var PostServiceGetFavoritedPostsArgs_Request_DEFAULT *post.GetFavoritedPostsReq
+
+func (p *PostServiceGetFavoritedPostsArgs) GetRequest() (v *post.GetFavoritedPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetFavoritedPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetFavoritedPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetFavoritedPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetFavoritedPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetFavoritedPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetFavoritedPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetFavoritedPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetFavoritedPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFavoritedPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetFavoritedPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetFavoritedPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetFavoritedPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetFavoritedPostsResult struct {
+	Success *post.GetFavoritedPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetFavoritedPostsResult() *PostServiceGetFavoritedPostsResult {
+	return &PostServiceGetFavoritedPostsResult{}
+}
+
+func (p *PostServiceGetFavoritedPostsResult) InitDefault() {
+}
+
+var PostServiceGetFavoritedPostsResult_Success_DEFAULT *post.GetFavoritedPostsResp
+
+func (p *PostServiceGetFavoritedPostsResult) GetSuccess() (v *post.GetFavoritedPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetFavoritedPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetFavoritedPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetFavoritedPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetFavoritedPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetFavoritedPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetFavoritedPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetFavoritedPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetFavoritedPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFavoritedPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetFavoritedPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetFavoritedPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetFavoritedPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetLikedPostsArgs struct {
+	Request *post.GetLikedPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetLikedPostsArgs() *PostServiceGetLikedPostsArgs {
+	return &PostServiceGetLikedPostsArgs{}
+}
+
+func (p *PostServiceGetLikedPostsArgs) InitDefault() {
+}
+
+var PostServiceGetLikedPostsArgs_Request_DEFAULT *post.GetLikedPostsReq
+
+func (p *PostServiceGetLikedPostsArgs) GetRequest() (v *post.GetLikedPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetLikedPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetLikedPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetLikedPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetLikedPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetLikedPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetLikedPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetLikedPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetLikedPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetLikedPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetLikedPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetLikedPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetLikedPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetLikedPostsResult struct {
+	Success *post.GetLikedPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetLikedPostsResult() *PostServiceGetLikedPostsResult {
+	return &PostServiceGetLikedPostsResult{}
+}
+
+func (p *PostServiceGetLikedPostsResult) InitDefault() {
+}
+
+var PostServiceGetLikedPostsResult_Success_DEFAULT *post.GetLikedPostsResp
+
+func (p *PostServiceGetLikedPostsResult) GetSuccess() (v *post.GetLikedPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetLikedPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetLikedPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetLikedPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetLikedPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetLikedPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetLikedPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetLikedPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetLikedPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetLikedPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetLikedPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetLikedPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetLikedPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetPostLikersArgs struct {
+	Request *post.GetPostLikersReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetPostLikersArgs() *PostServiceGetPostLikersArgs {
+	return &PostServiceGetPostLikersArgs{}
+}
+
+func (p *PostServiceGetPostLikersArgs) InitDefault() {
+}
+
+var PostServiceGetPostLikersArgs_Request_DEFAULT *post.GetPostLikersReq
+
+func (p *PostServiceGetPostLikersArgs) GetRequest() (v *post.GetPostLikersReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetPostLikersArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetPostLikersArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetPostLikersArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetPostLikersArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostLikersArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostLikersArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetPostLikersReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetPostLikersArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPostLikers_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostLikersArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetPostLikersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPostLikersArgs(%+v)", *p)
+
+}
+
+type PostServiceGetPostLikersResult struct {
+	Success *post.GetPostLikersResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetPostLikersResult() *PostServiceGetPostLikersResult {
+	return &PostServiceGetPostLikersResult{}
+}
+
+func (p *PostServiceGetPostLikersResult) InitDefault() {
+}
+
+var PostServiceGetPostLikersResult_Success_DEFAULT *post.GetPostLikersResp
+
+func (p *PostServiceGetPostLikersResult) GetSuccess() (v *post.GetPostLikersResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetPostLikersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetPostLikersResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetPostLikersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetPostLikersResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostLikersResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostLikersResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetPostLikersResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetPostLikersResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPostLikers_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostLikersResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetPostLikersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPostLikersResult(%+v)", *p)
+
+}
+
+type PostServiceReportPostArgs struct {
+	Request *post.ReportPostReq `thrift:"request,1"`
+}
+
+func NewPostServiceReportPostArgs() *PostServiceReportPostArgs {
+	return &PostServiceReportPostArgs{}
+}
+
+func (p *PostServiceReportPostArgs) InitDefault() {
+}
+
+var PostServiceReportPostArgs_Request_DEFAULT *post.ReportPostReq
+
+func (p *PostServiceReportPostArgs) GetRequest() (v *post.ReportPostReq) {
+	if !p.IsSetRequest() {
+		return PostServiceReportPostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceReportPostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceReportPostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceReportPostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceReportPostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceReportPostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewReportPostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceReportPostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReportPost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceReportPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceReportPostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceReportPostArgs(%+v)", *p)
+
+}
+
+type PostServiceReportPostResult struct {
+	Success *post.ReportPostResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceReportPostResult() *PostServiceReportPostResult {
+	return &PostServiceReportPostResult{}
+}
+
+func (p *PostServiceReportPostResult) InitDefault() {
+}
+
+var PostServiceReportPostResult_Success_DEFAULT *post.ReportPostResp
+
+func (p *PostServiceReportPostResult) GetSuccess() (v *post.ReportPostResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceReportPostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceReportPostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceReportPostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceReportPostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceReportPostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceReportPostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewReportPostResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceReportPostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ReportPost_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceReportPostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceReportPostResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceReportPostResult(%+v)", *p)
+
+}
+
+type PostServiceGetPendingReportsArgs struct {
+	Request *post.GetPendingReportsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetPendingReportsArgs() *PostServiceGetPendingReportsArgs {
+	return &PostServiceGetPendingReportsArgs{}
+}
+
+func (p *PostServiceGetPendingReportsArgs) InitDefault() {
+}
+
+var PostServiceGetPendingReportsArgs_Request_DEFAULT *post.GetPendingReportsReq
+
+func (p *PostServiceGetPendingReportsArgs) GetRequest() (v *post.GetPendingReportsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetPendingReportsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetPendingReportsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetPendingReportsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetPendingReportsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPendingReportsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPendingReportsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetPendingReportsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetPendingReportsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPendingReports_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPendingReportsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetPendingReportsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPendingReportsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetPendingReportsResult struct {
+	Success *post.GetPendingReportsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetPendingReportsResult() *PostServiceGetPendingReportsResult {
+	return &PostServiceGetPendingReportsResult{}
+}
+
+func (p *PostServiceGetPendingReportsResult) InitDefault() {
+}
+
+var PostServiceGetPendingReportsResult_Success_DEFAULT *post.GetPendingReportsResp
+
+func (p *PostServiceGetPendingReportsResult) GetSuccess() (v *post.GetPendingReportsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetPendingReportsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetPendingReportsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetPendingReportsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetPendingReportsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPendingReportsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPendingReportsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetPendingReportsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetPendingReportsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPendingReports_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPendingReportsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetPendingReportsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPendingReportsResult(%+v)", *p)
+
+}
+
+type PostServiceResolvePostReportsArgs struct {
+	Request *post.ResolvePostReportsReq `thrift:"request,1"`
+}
+
+func NewPostServiceResolvePostReportsArgs() *PostServiceResolvePostReportsArgs {
+	return &PostServiceResolvePostReportsArgs{}
+}
+
+func (p *PostServiceResolvePostReportsArgs) InitDefault() {
+}
+
+var PostServiceResolvePostReportsArgs_Request_DEFAULT *post.ResolvePostReportsReq
+
+func (p *PostServiceResolvePostReportsArgs) GetRequest() (v *post.ResolvePostReportsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceResolvePostReportsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceResolvePostReportsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceResolvePostReportsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceResolvePostReportsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceResolvePostReportsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceResolvePostReportsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewResolvePostReportsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceResolvePostReportsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResolvePostReports_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceResolvePostReportsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceResolvePostReportsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceResolvePostReportsArgs(%+v)", *p)
+
+}
+
+type PostServiceResolvePostReportsResult struct {
+	Success *post.ResolvePostReportsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceResolvePostReportsResult() *PostServiceResolvePostReportsResult {
+	return &PostServiceResolvePostReportsResult{}
+}
+
+func (p *PostServiceResolvePostReportsResult) InitDefault() {
+}
+
+var PostServiceResolvePostReportsResult_Success_DEFAULT *post.ResolvePostReportsResp
+
+func (p *PostServiceResolvePostReportsResult) GetSuccess() (v *post.ResolvePostReportsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceResolvePostReportsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceResolvePostReportsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceResolvePostReportsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceResolvePostReportsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceResolvePostReportsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceResolvePostReportsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewResolvePostReportsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceResolvePostReportsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("ResolvePostReports_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceResolvePostReportsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceResolvePostReportsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceResolvePostReportsResult(%+v)", *p)
+
+}
+
+type PostServiceGetModerationActionsArgs struct {
+	Request *post.GetModerationActionsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetModerationActionsArgs() *PostServiceGetModerationActionsArgs {
+	return &PostServiceGetModerationActionsArgs{}
+}
+
+func (p *PostServiceGetModerationActionsArgs) InitDefault() {
+}
+
+var PostServiceGetModerationActionsArgs_Request_DEFAULT *post.GetModerationActionsReq
+
+func (p *PostServiceGetModerationActionsArgs) GetRequest() (v *post.GetModerationActionsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetModerationActionsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetModerationActionsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetModerationActionsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetModerationActionsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetModerationActionsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetModerationActionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetModerationActionsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetModerationActionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetModerationActions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetModerationActionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetModerationActionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetModerationActionsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetModerationActionsResult struct {
+	Success *post.GetModerationActionsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetModerationActionsResult() *PostServiceGetModerationActionsResult {
+	return &PostServiceGetModerationActionsResult{}
+}
+
+func (p *PostServiceGetModerationActionsResult) InitDefault() {
+}
+
+var PostServiceGetModerationActionsResult_Success_DEFAULT *post.GetModerationActionsResp
+
+func (p *PostServiceGetModerationActionsResult) GetSuccess() (v *post.GetModerationActionsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetModerationActionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetModerationActionsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetModerationActionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetModerationActionsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetModerationActionsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetModerationActionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetModerationActionsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetModerationActionsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetModerationActions_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetModerationActionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetModerationActionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetModerationActionsResult(%+v)", *p)
+
+}
+
+type PostServiceGetMyModerationActionsArgs struct {
+	Request *post.GetMyModerationActionsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetMyModerationActionsArgs() *PostServiceGetMyModerationActionsArgs {
+	return &PostServiceGetMyModerationActionsArgs{}
+}
+
+func (p *PostServiceGetMyModerationActionsArgs) InitDefault() {
+}
+
+var PostServiceGetMyModerationActionsArgs_Request_DEFAULT *post.GetMyModerationActionsReq
+
+func (p *PostServiceGetMyModerationActionsArgs) GetRequest() (v *post.GetMyModerationActionsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetMyModerationActionsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetMyModerationActionsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetMyModerationActionsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetMyModerationActionsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetMyModerationActionsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMyModerationActionsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetMyModerationActionsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetMyModerationActionsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMyModerationActions_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMyModerationActionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetMyModerationActionsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetMyModerationActionsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetMyModerationActionsResult struct {
+	Success *post.GetModerationActionsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetMyModerationActionsResult() *PostServiceGetMyModerationActionsResult {
+	return &PostServiceGetMyModerationActionsResult{}
+}
+
+func (p *PostServiceGetMyModerationActionsResult) InitDefault() {
+}
+
+var PostServiceGetMyModerationActionsResult_Success_DEFAULT *post.GetModerationActionsResp
+
+func (p *PostServiceGetMyModerationActionsResult) GetSuccess() (v *post.GetModerationActionsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetMyModerationActionsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetMyModerationActionsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetMyModerationActionsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetMyModerationActionsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetMyModerationActionsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMyModerationActionsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetModerationActionsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetMyModerationActionsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMyModerationActions_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMyModerationActionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetMyModerationActionsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetMyModerationActionsResult(%+v)", *p)
+
+}
+
+type PostServicePinPostArgs struct {
+	Request *post.PinPostReq `thrift:"request,1"`
+}
+
+func NewPostServicePinPostArgs() *PostServicePinPostArgs {
+	return &PostServicePinPostArgs{}
+}
+
+func (p *PostServicePinPostArgs) InitDefault() {
+}
+
+var PostServicePinPostArgs_Request_DEFAULT *post.PinPostReq
+
+func (p *PostServicePinPostArgs) GetRequest() (v *post.PinPostReq) {
+	if !p.IsSetRequest() {
+		return PostServicePinPostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServicePinPostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServicePinPostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServicePinPostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServicePinPostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServicePinPostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewPinPostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServicePinPostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PinPost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServicePinPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServicePinPostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServicePinPostArgs(%+v)", *p)
+
+}
+
+type PostServicePinPostResult struct {
+	Success *post.PinPostResp `thrift:"success,0,optional"`
+}
+
+func NewPostServicePinPostResult() *PostServicePinPostResult {
+	return &PostServicePinPostResult{}
+}
+
+func (p *PostServicePinPostResult) InitDefault() {
+}
+
+var PostServicePinPostResult_Success_DEFAULT *post.PinPostResp
+
+func (p *PostServicePinPostResult) GetSuccess() (v *post.PinPostResp) {
+	if !p.IsSetSuccess() {
+		return PostServicePinPostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServicePinPostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServicePinPostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServicePinPostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServicePinPostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServicePinPostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewPinPostResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServicePinPostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("PinPost_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServicePinPostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServicePinPostResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServicePinPostResult(%+v)", *p)
+
+}
+
+type PostServiceUnpinPostArgs struct {
+	Request *post.UnpinPostReq `thrift:"request,1"`
+}
+
+func NewPostServiceUnpinPostArgs() *PostServiceUnpinPostArgs {
+	return &PostServiceUnpinPostArgs{}
+}
+
+func (p *PostServiceUnpinPostArgs) InitDefault() {
+}
+
+var PostServiceUnpinPostArgs_Request_DEFAULT *post.UnpinPostReq
+
+func (p *PostServiceUnpinPostArgs) GetRequest() (v *post.UnpinPostReq) {
+	if !p.IsSetRequest() {
+		return PostServiceUnpinPostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceUnpinPostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceUnpinPostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceUnpinPostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnpinPostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceUnpinPostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewUnpinPostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceUnpinPostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UnpinPost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceUnpinPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceUnpinPostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceUnpinPostArgs(%+v)", *p)
+
+}
+
+type PostServiceUnpinPostResult struct {
+	Success *post.UnpinPostResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceUnpinPostResult() *PostServiceUnpinPostResult {
+	return &PostServiceUnpinPostResult{}
+}
+
+func (p *PostServiceUnpinPostResult) InitDefault() {
+}
+
+var PostServiceUnpinPostResult_Success_DEFAULT *post.UnpinPostResp
+
+func (p *PostServiceUnpinPostResult) GetSuccess() (v *post.UnpinPostResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceUnpinPostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceUnpinPostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceUnpinPostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceUnpinPostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnpinPostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceUnpinPostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewUnpinPostResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceUnpinPostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UnpinPost_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceUnpinPostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceUnpinPostResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceUnpinPostResult(%+v)", *p)
+
+}
+
+type PostServiceGetPostQuotesArgs struct {
+	Request *post.GetPostQuotesReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetPostQuotesArgs() *PostServiceGetPostQuotesArgs {
+	return &PostServiceGetPostQuotesArgs{}
+}
+
+func (p *PostServiceGetPostQuotesArgs) InitDefault() {
+}
+
+var PostServiceGetPostQuotesArgs_Request_DEFAULT *post.GetPostQuotesReq
+
+func (p *PostServiceGetPostQuotesArgs) GetRequest() (v *post.GetPostQuotesReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetPostQuotesArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetPostQuotesArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetPostQuotesArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetPostQuotesArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostQuotesArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostQuotesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetPostQuotesReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetPostQuotesArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPostQuotes_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostQuotesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetPostQuotesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPostQuotesArgs(%+v)", *p)
+
+}
+
+type PostServiceGetPostQuotesResult struct {
+	Success *post.GetPostQuotesResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetPostQuotesResult() *PostServiceGetPostQuotesResult {
+	return &PostServiceGetPostQuotesResult{}
+}
+
+func (p *PostServiceGetPostQuotesResult) InitDefault() {
+}
+
+var PostServiceGetPostQuotesResult_Success_DEFAULT *post.GetPostQuotesResp
+
+func (p *PostServiceGetPostQuotesResult) GetSuccess() (v *post.GetPostQuotesResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetPostQuotesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetPostQuotesResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetPostQuotesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetPostQuotesResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostQuotesResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostQuotesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetPostQuotesResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetPostQuotesResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPostQuotes_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPostQuotesResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetPostQuotesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPostQuotesResult(%+v)", *p)
+
+}
+
+type PostServiceGetMentionedPostsArgs struct {
+	Request *post.GetMentionedPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetMentionedPostsArgs() *PostServiceGetMentionedPostsArgs {
+	return &PostServiceGetMentionedPostsArgs{}
+}
+
+func (p *PostServiceGetMentionedPostsArgs) InitDefault() {
+}
+
+var PostServiceGetMentionedPostsArgs_Request_DEFAULT *post.GetMentionedPostsReq
+
+func (p *PostServiceGetMentionedPostsArgs) GetRequest() (v *post.GetMentionedPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetMentionedPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetMentionedPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetMentionedPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetMentionedPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetMentionedPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMentionedPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetMentionedPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetMentionedPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMentionedPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMentionedPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetMentionedPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetMentionedPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetMentionedPostsResult struct {
+	Success *post.GetMentionedPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetMentionedPostsResult() *PostServiceGetMentionedPostsResult {
+	return &PostServiceGetMentionedPostsResult{}
+}
+
+func (p *PostServiceGetMentionedPostsResult) InitDefault() {
+}
+
+var PostServiceGetMentionedPostsResult_Success_DEFAULT *post.GetMentionedPostsResp
+
+func (p *PostServiceGetMentionedPostsResult) GetSuccess() (v *post.GetMentionedPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetMentionedPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetMentionedPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetMentionedPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetMentionedPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetMentionedPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMentionedPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetMentionedPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetMentionedPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetMentionedPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetMentionedPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetMentionedPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetMentionedPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetPersonalRecentPostsArgs struct {
+	Request *post.GetPersonalRecentPostsResp `thrift:"request,1"`
+}
+
+func NewPostServiceGetPersonalRecentPostsArgs() *PostServiceGetPersonalRecentPostsArgs {
+	return &PostServiceGetPersonalRecentPostsArgs{}
+}
+
+func (p *PostServiceGetPersonalRecentPostsArgs) InitDefault() {
+}
+
+var PostServiceGetPersonalRecentPostsArgs_Request_DEFAULT *post.GetPersonalRecentPostsResp
+
+func (p *PostServiceGetPersonalRecentPostsArgs) GetRequest() (v *post.GetPersonalRecentPostsResp) {
+	if !p.IsSetRequest() {
+		return PostServiceGetPersonalRecentPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetPersonalRecentPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetPersonalRecentPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetPersonalRecentPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPersonalRecentPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPersonalRecentPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetPersonalRecentPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetPersonalRecentPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPersonalRecentPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPersonalRecentPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetPersonalRecentPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPersonalRecentPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetPersonalRecentPostsResult struct {
+	Success *post.GetPersonalRecentPostsReq `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetPersonalRecentPostsResult() *PostServiceGetPersonalRecentPostsResult {
+	return &PostServiceGetPersonalRecentPostsResult{}
+}
+
+func (p *PostServiceGetPersonalRecentPostsResult) InitDefault() {
+}
+
+var PostServiceGetPersonalRecentPostsResult_Success_DEFAULT *post.GetPersonalRecentPostsReq
+
+func (p *PostServiceGetPersonalRecentPostsResult) GetSuccess() (v *post.GetPersonalRecentPostsReq) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetPersonalRecentPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetPersonalRecentPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetPersonalRecentPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetPersonalRecentPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPersonalRecentPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPersonalRecentPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetPersonalRecentPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetPersonalRecentPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetPersonalRecentPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetPersonalRecentPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetPersonalRecentPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetPersonalRecentPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetFollowingUsersRecentPostsArgs struct {
+	Request *post.GetFollowingUsersRecentPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetFollowingUsersRecentPostsArgs() *PostServiceGetFollowingUsersRecentPostsArgs {
+	return &PostServiceGetFollowingUsersRecentPostsArgs{}
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsArgs) InitDefault() {
+}
+
+var PostServiceGetFollowingUsersRecentPostsArgs_Request_DEFAULT *post.GetFollowingUsersRecentPostsReq
+
+func (p *PostServiceGetFollowingUsersRecentPostsArgs) GetRequest() (v *post.GetFollowingUsersRecentPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetFollowingUsersRecentPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetFollowingUsersRecentPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetFollowingUsersRecentPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetFollowingUsersRecentPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowingUsersRecentPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetFollowingUsersRecentPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetFollowingUsersRecentPostsResult struct {
+	Success *post.GetFollowingUsersRecentPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetFollowingUsersRecentPostsResult() *PostServiceGetFollowingUsersRecentPostsResult {
+	return &PostServiceGetFollowingUsersRecentPostsResult{}
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsResult) InitDefault() {
+}
+
+var PostServiceGetFollowingUsersRecentPostsResult_Success_DEFAULT *post.GetFollowingUsersRecentPostsResp
+
+func (p *PostServiceGetFollowingUsersRecentPostsResult) GetSuccess() (v *post.GetFollowingUsersRecentPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetFollowingUsersRecentPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetFollowingUsersRecentPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetFollowingUsersRecentPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetFollowingUsersRecentPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetFollowingUsersRecentPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetFollowingUsersRecentPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetFollowingUsersRecentPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetSchoolHotPostsArgs struct {
+	Request *post.GetSchoolHotPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetSchoolHotPostsArgs() *PostServiceGetSchoolHotPostsArgs {
+	return &PostServiceGetSchoolHotPostsArgs{}
+}
+
+func (p *PostServiceGetSchoolHotPostsArgs) InitDefault() {
+}
+
+var PostServiceGetSchoolHotPostsArgs_Request_DEFAULT *post.GetSchoolHotPostsReq
+
+func (p *PostServiceGetSchoolHotPostsArgs) GetRequest() (v *post.GetSchoolHotPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetSchoolHotPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetSchoolHotPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetSchoolHotPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetSchoolHotPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetSchoolHotPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolHotPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetSchoolHotPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetSchoolHotPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetSchoolHotPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolHotPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolHotPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetSchoolHotPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetSchoolHotPostsResult struct {
+	Success *post.GetSchoolHotPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetSchoolHotPostsResult() *PostServiceGetSchoolHotPostsResult {
+	return &PostServiceGetSchoolHotPostsResult{}
+}
+
+func (p *PostServiceGetSchoolHotPostsResult) InitDefault() {
+}
+
+var PostServiceGetSchoolHotPostsResult_Success_DEFAULT *post.GetSchoolHotPostsResp
+
+func (p *PostServiceGetSchoolHotPostsResult) GetSuccess() (v *post.GetSchoolHotPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetSchoolHotPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetSchoolHotPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetSchoolHotPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetSchoolHotPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetSchoolHotPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolHotPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetSchoolHotPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetSchoolHotPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetSchoolHotPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolHotPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetSchoolHotPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetSchoolHotPostsResult(%+v)", *p)
+
+}
+
+type PostServiceGetHotPostsArgs struct {
+	Request *post.GetHotPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceGetHotPostsArgs() *PostServiceGetHotPostsArgs {
+	return &PostServiceGetHotPostsArgs{}
+}
+
+func (p *PostServiceGetHotPostsArgs) InitDefault() {
+}
+
+var PostServiceGetHotPostsArgs_Request_DEFAULT *post.GetHotPostsReq
+
+func (p *PostServiceGetHotPostsArgs) GetRequest() (v *post.GetHotPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceGetHotPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceGetHotPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceGetHotPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceGetHotPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetHotPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetHotPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewGetHotPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceGetHotPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetHotPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetHotPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceGetHotPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetHotPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceGetHotPostsResult struct {
+	Success *post.GetHotPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceGetHotPostsResult() *PostServiceGetHotPostsResult {
+	return &PostServiceGetHotPostsResult{}
+}
+
+func (p *PostServiceGetHotPostsResult) InitDefault() {
+}
+
+var PostServiceGetHotPostsResult_Success_DEFAULT *post.GetHotPostsResp
+
+func (p *PostServiceGetHotPostsResult) GetSuccess() (v *post.GetHotPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceGetHotPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceGetHotPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceGetHotPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceGetHotPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetHotPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceGetHotPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewGetHotPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceGetHotPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("GetHotPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceGetHotPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceGetHotPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceGetHotPostsResult(%+v)", *p)
+
+}
+
+type PostServiceSearchPostsArgs struct {
+	Request *post.SearchPostsReq `thrift:"request,1"`
+}
+
+func NewPostServiceSearchPostsArgs() *PostServiceSearchPostsArgs {
+	return &PostServiceSearchPostsArgs{}
+}
+
+func (p *PostServiceSearchPostsArgs) InitDefault() {
+}
+
+var PostServiceSearchPostsArgs_Request_DEFAULT *post.SearchPostsReq
+
+func (p *PostServiceSearchPostsArgs) GetRequest() (v *post.SearchPostsReq) {
+	if !p.IsSetRequest() {
+		return PostServiceSearchPostsArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceSearchPostsArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceSearchPostsArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceSearchPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceSearchPostsArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceSearchPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewSearchPostsReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceSearchPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchPosts_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceSearchPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceSearchPostsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceSearchPostsArgs(%+v)", *p)
+
+}
+
+type PostServiceSearchPostsResult struct {
+	Success *post.SearchPostsResp `thrift:"success,0,optional"`
+}
+
+func NewPostServiceSearchPostsResult() *PostServiceSearchPostsResult {
+	return &PostServiceSearchPostsResult{}
+}
+
+func (p *PostServiceSearchPostsResult) InitDefault() {
+}
+
+var PostServiceSearchPostsResult_Success_DEFAULT *post.SearchPostsResp
+
+func (p *PostServiceSearchPostsResult) GetSuccess() (v *post.SearchPostsResp) {
+	if !p.IsSetSuccess() {
+		return PostServiceSearchPostsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceSearchPostsResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceSearchPostsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceSearchPostsResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceSearchPostsResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceSearchPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewSearchPostsResp()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceSearchPostsResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("SearchPosts_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceSearchPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceSearchPostsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceSearchPostsResult(%+v)", *p)
+
+}
+
+type PostServiceLikePostArgs struct {
+	Request *post.UserFlagPostResq `thrift:"request,1"`
+}
+
+func NewPostServiceLikePostArgs() *PostServiceLikePostArgs {
+	return &PostServiceLikePostArgs{}
+}
+
+func (p *PostServiceLikePostArgs) InitDefault() {
+}
+
+var PostServiceLikePostArgs_Request_DEFAULT *post.UserFlagPostResq
+
+func (p *PostServiceLikePostArgs) GetRequest() (v *post.UserFlagPostResq) {
+	if !p.IsSetRequest() {
+		return PostServiceLikePostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceLikePostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceLikePostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceLikePostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceLikePostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceLikePostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewUserFlagPostResq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceLikePostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LikePost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceLikePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceLikePostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceLikePostArgs(%+v)", *p)
+
+}
+
+type PostServiceLikePostResult struct {
+	Success *post.LikePostReq `thrift:"success,0,optional"`
+}
+
+func NewPostServiceLikePostResult() *PostServiceLikePostResult {
+	return &PostServiceLikePostResult{}
+}
+
+func (p *PostServiceLikePostResult) InitDefault() {
+}
+
+var PostServiceLikePostResult_Success_DEFAULT *post.LikePostReq
+
+func (p *PostServiceLikePostResult) GetSuccess() (v *post.LikePostReq) {
+	if !p.IsSetSuccess() {
+		return PostServiceLikePostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceLikePostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceLikePostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceLikePostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceLikePostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceLikePostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewLikePostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Success = _field
+	return nil
+}
+
+func (p *PostServiceLikePostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("LikePost_result"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceLikePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceLikePostResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceLikePostResult(%+v)", *p)
+
+}
+
+type PostServiceUnlikePostArgs struct {
+	Request *post.UserFlagPostResq `thrift:"request,1"`
+}
+
+func NewPostServiceUnlikePostArgs() *PostServiceUnlikePostArgs {
+	return &PostServiceUnlikePostArgs{}
+}
+
+func (p *PostServiceUnlikePostArgs) InitDefault() {
+}
+
+var PostServiceUnlikePostArgs_Request_DEFAULT *post.UserFlagPostResq
+
+func (p *PostServiceUnlikePostArgs) GetRequest() (v *post.UserFlagPostResq) {
+	if !p.IsSetRequest() {
+		return PostServiceUnlikePostArgs_Request_DEFAULT
+	}
+	return p.Request
+}
+
+var fieldIDToName_PostServiceUnlikePostArgs = map[int16]string{
+	1: "request",
+}
+
+func (p *PostServiceUnlikePostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
+
+func (p *PostServiceUnlikePostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
+	}
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
+	}
+
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnlikePostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+}
+
+func (p *PostServiceUnlikePostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewUserFlagPostResq()
+	if err := _field.Read(iprot); err != nil {
+		return err
+	}
+	p.Request = _field
+	return nil
+}
+
+func (p *PostServiceUnlikePostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UnlikePost_args"); err != nil {
+		goto WriteStructBeginError
+	}
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
+	}
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
+	}
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
+	}
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceUnlikePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
+	}
+	if err := p.Request.Write(oprot); err != nil {
+		return err
+	}
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+}
+
+func (p *PostServiceUnlikePostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceUnlikePostArgs(%+v)", *p)
+
+}
+
+type PostServiceUnlikePostResult struct {
+	Success *post.UnlikePostReq `thrift:"success,0,optional"`
+}
+
+func NewPostServiceUnlikePostResult() *PostServiceUnlikePostResult {
+	return &PostServiceUnlikePostResult{}
+}
+
+func (p *PostServiceUnlikePostResult) InitDefault() {
+}
+
+var PostServiceUnlikePostResult_Success_DEFAULT *post.UnlikePostReq
+
+func (p *PostServiceUnlikePostResult) GetSuccess() (v *post.UnlikePostReq) {
+	if !p.IsSetSuccess() {
+		return PostServiceUnlikePostResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+var fieldIDToName_PostServiceUnlikePostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceUnlikePostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceUnlikePostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type postServiceProcessorDeletePost struct {
-	handler PostService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnlikePostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *postServiceProcessorDeletePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceDeletePostArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("DeletePost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *PostServiceUnlikePostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewUnlikePostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceDeletePostResult{}
-	var retval *post.DeletePostResp
-	if retval, err2 = p.handler.DeletePost(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeletePost: "+err2.Error())
-		oprot.WriteMessageBegin("DeletePost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *PostServiceUnlikePostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("UnlikePost_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("DeletePost", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceUnlikePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *PostServiceUnlikePostResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true, err
+	return fmt.Sprintf("PostServiceUnlikePostResult(%+v)", *p)
+
 }
 
-type postServiceProcessorGetSchoolRecentPosts struct {
-	handler PostService
+type PostServiceFavPostArgs struct {
+	Request *post.UserFlagPostResq `thrift:"request,1"`
 }
 
-func (p *postServiceProcessorGetSchoolRecentPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceGetSchoolRecentPostsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetSchoolRecentPosts", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func NewPostServiceFavPostArgs() *PostServiceFavPostArgs {
+	return &PostServiceFavPostArgs{}
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceGetSchoolRecentPostsResult{}
-	var retval *post.GetSchoolRecentPostsResp
-	if retval, err2 = p.handler.GetSchoolRecentPosts(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetSchoolRecentPosts: "+err2.Error())
-		oprot.WriteMessageBegin("GetSchoolRecentPosts", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetSchoolRecentPosts", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
+func (p *PostServiceFavPostArgs) InitDefault() {
+}
+
+var PostServiceFavPostArgs_Request_DEFAULT *post.UserFlagPostResq
+
+func (p *PostServiceFavPostArgs) GetRequest() (v *post.UserFlagPostResq) {
+	if !p.IsSetRequest() {
+		return PostServiceFavPostArgs_Request_DEFAULT
 	}
-	return true, err
+	return p.Request
 }
 
-type postServiceProcessorGetPersonalRecentPosts struct {
-	handler PostService
+var fieldIDToName_PostServiceFavPostArgs = map[int16]string{
+	1: "request",
 }
 
-func (p *postServiceProcessorGetPersonalRecentPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceGetPersonalRecentPostsArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetPersonalRecentPosts", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+func (p *PostServiceFavPostArgs) IsSetRequest() bool {
+	return p.Request != nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceGetPersonalRecentPostsResult{}
-	var retval *post.GetPersonalRecentPostsReq
-	if retval, err2 = p.handler.GetPersonalRecentPosts(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPersonalRecentPosts: "+err2.Error())
-		oprot.WriteMessageBegin("GetPersonalRecentPosts", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetPersonalRecentPosts", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+func (p *PostServiceFavPostArgs) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 1:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField1(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type postServiceProcessorLikePost struct {
-	handler PostService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceFavPostArgs[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *postServiceProcessorLikePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceLikePostArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("LikePost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *PostServiceFavPostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewUserFlagPostResq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Request = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceLikePostResult{}
-	var retval *post.LikePostReq
-	if retval, err2 = p.handler.LikePost(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LikePost: "+err2.Error())
-		oprot.WriteMessageBegin("LikePost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
+func (p *PostServiceFavPostArgs) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FavPost_args"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageBegin("LikePost", thrift.REPLY, seqId); err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField1(oprot); err != nil {
+			fieldId = 1
+			goto WriteFieldError
+		}
 	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+}
+
+func (p *PostServiceFavPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+		goto WriteFieldBeginError
 	}
-	if err != nil {
-		return
+	if err := p.Request.Write(oprot); err != nil {
+		return err
 	}
-	return true, err
+	if err = oprot.WriteFieldEnd(); err != nil {
+		goto WriteFieldEndError
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-type postServiceProcessorUnlikePost struct {
-	handler PostService
+func (p *PostServiceFavPostArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PostServiceFavPostArgs(%+v)", *p)
+
 }
 
-func (p *postServiceProcessorUnlikePost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceUnlikePostArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UnlikePost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+type PostServiceFavPostResult struct {
+	Success *post.FavPostReq `thrift:"success,0,optional"`
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceUnlikePostResult{}
-	var retval *post.UnlikePostReq
-	if retval, err2 = p.handler.UnlikePost(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UnlikePost: "+err2.Error())
-		oprot.WriteMessageBegin("UnlikePost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UnlikePost", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
+func NewPostServiceFavPostResult() *PostServiceFavPostResult {
+	return &PostServiceFavPostResult{}
 }
 
-type postServiceProcessorFavPost struct {
-	handler PostService
+func (p *PostServiceFavPostResult) InitDefault() {
 }
 
-func (p *postServiceProcessorFavPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceFavPostArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("FavPost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
+var PostServiceFavPostResult_Success_DEFAULT *post.FavPostReq
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceFavPostResult{}
-	var retval *post.FavPostReq
-	if retval, err2 = p.handler.FavPost(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing FavPost: "+err2.Error())
-		oprot.WriteMessageBegin("FavPost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("FavPost", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *PostServiceFavPostResult) GetSuccess() (v *post.FavPostReq) {
+	if !p.IsSetSuccess() {
+		return PostServiceFavPostResult_Success_DEFAULT
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	return p.Success
+}
+
+var fieldIDToName_PostServiceFavPostResult = map[int16]string{
+	0: "success",
+}
+
+func (p *PostServiceFavPostResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *PostServiceFavPostResult) Read(iprot thrift.TProtocol) (err error) {
+
+	var fieldTypeId thrift.TType
+	var fieldId int16
+
+	if _, err = iprot.ReadStructBegin(); err != nil {
+		goto ReadStructBeginError
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+
+	for {
+		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
+		if err != nil {
+			goto ReadFieldBeginError
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+
+		switch fieldId {
+		case 0:
+			if fieldTypeId == thrift.STRUCT {
+				if err = p.ReadField0(iprot); err != nil {
+					goto ReadFieldError
+				}
+			} else if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		default:
+			if err = iprot.Skip(fieldTypeId); err != nil {
+				goto SkipFieldError
+			}
+		}
+		if err = iprot.ReadFieldEnd(); err != nil {
+			goto ReadFieldEndError
+		}
 	}
-	if err != nil {
-		return
+	if err = iprot.ReadStructEnd(); err != nil {
+		goto ReadStructEndError
 	}
-	return true, err
-}
 
-type postServiceProcessorUnfavPost struct {
-	handler PostService
+	return nil
+ReadStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
+ReadFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
+ReadFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceFavPostResult[fieldId]), err)
+SkipFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
+
+ReadFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
+ReadStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *postServiceProcessorUnfavPost) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceUnfavPostArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UnfavPost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *PostServiceFavPostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewFavPostReq()
+	if err := _field.Read(iprot); err != nil {
+		return err
 	}
+	p.Success = _field
+	return nil
+}
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceUnfavPostResult{}
-	var retval *post.UnfavPostReq
-	if retval, err2 = p.handler.UnfavPost(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UnfavPost: "+err2.Error())
-		oprot.WriteMessageBegin("UnfavPost", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UnfavPost", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
+func (p *PostServiceFavPostResult) Write(oprot thrift.TProtocol) (err error) {
+	var fieldId int16
+	if err = oprot.WriteStructBegin("FavPost_result"); err != nil {
+		goto WriteStructBeginError
 	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
+	if p != nil {
+		if err = p.writeField0(oprot); err != nil {
+			fieldId = 0
+			goto WriteFieldError
+		}
 	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
+	if err = oprot.WriteFieldStop(); err != nil {
+		goto WriteFieldStopError
 	}
-	if err != nil {
-		return
+	if err = oprot.WriteStructEnd(); err != nil {
+		goto WriteStructEndError
 	}
-	return true, err
+	return nil
+WriteStructBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+WriteFieldError:
+	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
+WriteFieldStopError:
+	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
+WriteStructEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-type postServiceProcessorUploadPostMedia struct {
-	handler PostService
+func (p *PostServiceFavPostResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *postServiceProcessorUploadPostMedia) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := PostServiceUploadPostMediaArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("UploadPostMedia", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
+func (p *PostServiceFavPostResult) String() string {
+	if p == nil {
+		return "<nil>"
 	}
+	return fmt.Sprintf("PostServiceFavPostResult(%+v)", *p)
 
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := PostServiceUploadPostMediaResult{}
-	var retval *post.UploadPostMediaResp
-	if retval, err2 = p.handler.UploadPostMedia(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UploadPostMedia: "+err2.Error())
-		oprot.WriteMessageBegin("UploadPostMedia", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("UploadPostMedia", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type PostServiceGetPostByIDArgs struct {
-	Request *post.GetPostByIDReq `thrift:"request,1"`
+type PostServiceUnfavPostArgs struct {
+	Request *post.UserFlagPostResq `thrift:"request,1"`
 }
 
-func NewPostServiceGetPostByIDArgs() *PostServiceGetPostByIDArgs {
-	return &PostServiceGetPostByIDArgs{}
+func NewPostServiceUnfavPostArgs() *PostServiceUnfavPostArgs {
+	return &PostServiceUnfavPostArgs{}
 }
 
-func (p *PostServiceGetPostByIDArgs) InitDefault() {
+func (p *PostServiceUnfavPostArgs) InitDefault() {
 }
 
-var PostServiceGetPostByIDArgs_Request_DEFAULT *post.GetPostByIDReq
+var PostServiceUnfavPostArgs_Request_DEFAULT *post.UserFlagPostResq
 
-func (p *PostServiceGetPostByIDArgs) GetRequest() (v *post.GetPostByIDReq) {
+func (p *PostServiceUnfavPostArgs) GetRequest() (v *post.UserFlagPostResq) {
 	if !p.IsSetRequest() {
-		return PostServiceGetPostByIDArgs_Request_DEFAULT
+		return PostServiceUnfavPostArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceGetPostByIDArgs = map[int16]string{
+var fieldIDToName_PostServiceUnfavPostArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceGetPostByIDArgs) IsSetRequest() bool {
+func (p *PostServiceUnfavPostArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceGetPostByIDArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PostServiceUnfavPostArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6416,7 +19477,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostByIDArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnfavPostArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6426,8 +19487,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceGetPostByIDArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewGetPostByIDReq()
+func (p *PostServiceUnfavPostArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewUserFlagPostResq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6435,9 +19496,9 @@ func (p *PostServiceGetPostByIDArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceGetPostByIDArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PostServiceUnfavPostArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPostByID_args"); err != nil {
+	if err = oprot.WriteStructBegin("UnfavPost_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6463,7 +19524,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceGetPostByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PostServiceUnfavPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6480,43 +19541,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceGetPostByIDArgs) String() string {
+func (p *PostServiceUnfavPostArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceGetPostByIDArgs(%+v)", *p)
+	return fmt.Sprintf("PostServiceUnfavPostArgs(%+v)", *p)
 
 }
 
-type PostServiceGetPostByIDResult struct {
-	Success *post.GetPostByIDResp `thrift:"success,0,optional"`
+type PostServiceUnfavPostResult struct {
+	Success *post.UnfavPostReq `thrift:"success,0,optional"`
 }
 
-func NewPostServiceGetPostByIDResult() *PostServiceGetPostByIDResult {
-	return &PostServiceGetPostByIDResult{}
+func NewPostServiceUnfavPostResult() *PostServiceUnfavPostResult {
+	return &PostServiceUnfavPostResult{}
 }
 
-func (p *PostServiceGetPostByIDResult) InitDefault() {
+func (p *PostServiceUnfavPostResult) InitDefault() {
 }
 
-var PostServiceGetPostByIDResult_Success_DEFAULT *post.GetPostByIDResp
+var PostServiceUnfavPostResult_Success_DEFAULT *post.UnfavPostReq
 
-func (p *PostServiceGetPostByIDResult) GetSuccess() (v *post.GetPostByIDResp) {
+func (p *PostServiceUnfavPostResult) GetSuccess() (v *post.UnfavPostReq) {
 	if !p.IsSetSuccess() {
-		return PostServiceGetPostByIDResult_Success_DEFAULT
+		return PostServiceUnfavPostResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceGetPostByIDResult = map[int16]string{
+var fieldIDToName_PostServiceUnfavPostResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceGetPostByIDResult) IsSetSuccess() bool {
+func (p *PostServiceUnfavPostResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceGetPostByIDResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PostServiceUnfavPostResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6562,7 +19623,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPostByIDResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnfavPostResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6572,8 +19633,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceGetPostByIDResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewGetPostByIDResp()
+func (p *PostServiceUnfavPostResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewUnfavPostReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6581,9 +19642,9 @@ func (p *PostServiceGetPostByIDResult) ReadField0(iprot thrift.TProtocol) error
 	return nil
 }
 
-func (p *PostServiceGetPostByIDResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PostServiceUnfavPostResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPostByID_result"); err != nil {
+	if err = oprot.WriteStructBegin("UnfavPost_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6609,7 +19670,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceGetPostByIDResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PostServiceUnfavPostResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6628,43 +19689,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceGetPostByIDResult) String() string {
+func (p *PostServiceUnfavPostResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceGetPostByIDResult(%+v)", *p)
+	return fmt.Sprintf("PostServiceUnfavPostResult(%+v)", *p)
 
 }
 
-type PostServiceCreatePostArgs struct {
-	Request *post.CreatePostReq `thrift:"request,1"`
+type PostServiceUploadPostMediaArgs struct {
+	Request *post.UploadPostMediaReq `thrift:"request,1"`
 }
 
-func NewPostServiceCreatePostArgs() *PostServiceCreatePostArgs {
-	return &PostServiceCreatePostArgs{}
+func NewPostServiceUploadPostMediaArgs() *PostServiceUploadPostMediaArgs {
+	return &PostServiceUploadPostMediaArgs{}
 }
 
-func (p *PostServiceCreatePostArgs) InitDefault() {
+func (p *PostServiceUploadPostMediaArgs) InitDefault() {
 }
 
-var PostServiceCreatePostArgs_Request_DEFAULT *post.CreatePostReq
+var PostServiceUploadPostMediaArgs_Request_DEFAULT *post.UploadPostMediaReq
 
-func (p *PostServiceCreatePostArgs) GetRequest() (v *post.CreatePostReq) {
+func (p *PostServiceUploadPostMediaArgs) GetRequest() (v *post.UploadPostMediaReq) {
 	if !p.IsSetRequest() {
-		return PostServiceCreatePostArgs_Request_DEFAULT
+		return PostServiceUploadPostMediaArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceCreatePostArgs = map[int16]string{
+var fieldIDToName_PostServiceUploadPostMediaArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceCreatePostArgs) IsSetRequest() bool {
+func (p *PostServiceUploadPostMediaArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceCreatePostArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *PostServiceUploadPostMediaArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6710,7 +19771,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceCreatePostArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUploadPostMediaArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6720,8 +19781,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceCreatePostArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewCreatePostReq()
+func (p *PostServiceUploadPostMediaArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := post.NewUploadPostMediaReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6729,9 +19790,9 @@ func (p *PostServiceCreatePostArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceCreatePostArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *PostServiceUploadPostMediaArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreatePost_args"); err != nil {
+	if err = oprot.WriteStructBegin("UploadPostMedia_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6757,7 +19818,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceCreatePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *PostServiceUploadPostMediaArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -6774,43 +19835,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceCreatePostArgs) String() string {
+func (p *PostServiceUploadPostMediaArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceCreatePostArgs(%+v)", *p)
+	return fmt.Sprintf("PostServiceUploadPostMediaArgs(%+v)", *p)
 
 }
 
-type PostServiceCreatePostResult struct {
-	Success *post.CreatePostResp `thrift:"success,0,optional"`
+type PostServiceUploadPostMediaResult struct {
+	Success *post.UploadPostMediaResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceCreatePostResult() *PostServiceCreatePostResult {
-	return &PostServiceCreatePostResult{}
+func NewPostServiceUploadPostMediaResult() *PostServiceUploadPostMediaResult {
+	return &PostServiceUploadPostMediaResult{}
 }
 
-func (p *PostServiceCreatePostResult) InitDefault() {
+func (p *PostServiceUploadPostMediaResult) InitDefault() {
 }
 
-var PostServiceCreatePostResult_Success_DEFAULT *post.CreatePostResp
+var PostServiceUploadPostMediaResult_Success_DEFAULT *post.UploadPostMediaResp
 
-func (p *PostServiceCreatePostResult) GetSuccess() (v *post.CreatePostResp) {
+func (p *PostServiceUploadPostMediaResult) GetSuccess() (v *post.UploadPostMediaResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceCreatePostResult_Success_DEFAULT
+		return PostServiceUploadPostMediaResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceCreatePostResult = map[int16]string{
+var fieldIDToName_PostServiceUploadPostMediaResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceCreatePostResult) IsSetSuccess() bool {
+func (p *PostServiceUploadPostMediaResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceCreatePostResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *PostServiceUploadPostMediaResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -6856,7 +19917,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceCreatePostResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUploadPostMediaResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -6866,8 +19927,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceCreatePostResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewCreatePostResp()
+func (p *PostServiceUploadPostMediaResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := post.NewUploadPostMediaResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -6875,9 +19936,9 @@ func (p *PostServiceCreatePostResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceCreatePostResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *PostServiceUploadPostMediaResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("CreatePost_result"); err != nil {
+	if err = oprot.WriteStructBegin("UploadPostMedia_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -6903,7 +19964,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceCreatePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *PostServiceUploadPostMediaResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -6922,43 +19983,132 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceCreatePostResult) String() string {
+func (p *PostServiceUploadPostMediaResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceCreatePostResult(%+v)", *p)
+	return fmt.Sprintf("PostServiceUploadPostMediaResult(%+v)", *p)
 
 }
 
-type PostServiceEditPostArgs struct {
-	Request *post.EditPostReq `thrift:"request,1"`
+type SchoolServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      SchoolService
 }
 
-func NewPostServiceEditPostArgs() *PostServiceEditPostArgs {
-	return &PostServiceEditPostArgs{}
+func (p *SchoolServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
 }
 
-func (p *PostServiceEditPostArgs) InitDefault() {
+func (p *SchoolServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
 }
 
-var PostServiceEditPostArgs_Request_DEFAULT *post.EditPostReq
+func (p *SchoolServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
 
-func (p *PostServiceEditPostArgs) GetRequest() (v *post.EditPostReq) {
+func NewSchoolServiceProcessor(handler SchoolService) *SchoolServiceProcessor {
+	self := &SchoolServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetAllSchools", &schoolServiceProcessorGetAllSchools{handler: handler})
+	return self
+}
+func (p *SchoolServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type schoolServiceProcessorGetAllSchools struct {
+	handler SchoolService
+}
+
+func (p *schoolServiceProcessorGetAllSchools) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := SchoolServiceGetAllSchoolsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAllSchools", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := SchoolServiceGetAllSchoolsResult{}
+	var retval *school.GetAllSchoolsResp
+	if retval, err2 = p.handler.GetAllSchools(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllSchools: "+err2.Error())
+		oprot.WriteMessageBegin("GetAllSchools", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAllSchools", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
+
+type SchoolServiceGetAllSchoolsArgs struct {
+	Request *school.GetAllSchoolsReq `thrift:"request,1"`
+}
+
+func NewSchoolServiceGetAllSchoolsArgs() *SchoolServiceGetAllSchoolsArgs {
+	return &SchoolServiceGetAllSchoolsArgs{}
+}
+
+func (p *SchoolServiceGetAllSchoolsArgs) InitDefault() {
+}
+
+var SchoolServiceGetAllSchoolsArgs_Request_DEFAULT *school.GetAllSchoolsReq
+
+func (p *SchoolServiceGetAllSchoolsArgs) GetRequest() (v *school.GetAllSchoolsReq) {
 	if !p.IsSetRequest() {
-		return PostServiceEditPostArgs_Request_DEFAULT
+		return SchoolServiceGetAllSchoolsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceEditPostArgs = map[int16]string{
+var fieldIDToName_SchoolServiceGetAllSchoolsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceEditPostArgs) IsSetRequest() bool {
+func (p *SchoolServiceGetAllSchoolsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceEditPostArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *SchoolServiceGetAllSchoolsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7004,7 +20154,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceEditPostArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SchoolServiceGetAllSchoolsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7014,8 +20164,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceEditPostArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewEditPostReq()
+func (p *SchoolServiceGetAllSchoolsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := school.NewGetAllSchoolsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7023,9 +20173,9 @@ func (p *PostServiceEditPostArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceEditPostArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *SchoolServiceGetAllSchoolsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EditPost_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllSchools_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7051,7 +20201,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceEditPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *SchoolServiceGetAllSchoolsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7068,43 +20218,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceEditPostArgs) String() string {
+func (p *SchoolServiceGetAllSchoolsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceEditPostArgs(%+v)", *p)
+	return fmt.Sprintf("SchoolServiceGetAllSchoolsArgs(%+v)", *p)
 
 }
 
-type PostServiceEditPostResult struct {
-	Success *post.EditPostResp `thrift:"success,0,optional"`
+type SchoolServiceGetAllSchoolsResult struct {
+	Success *school.GetAllSchoolsResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceEditPostResult() *PostServiceEditPostResult {
-	return &PostServiceEditPostResult{}
+func NewSchoolServiceGetAllSchoolsResult() *SchoolServiceGetAllSchoolsResult {
+	return &SchoolServiceGetAllSchoolsResult{}
 }
 
-func (p *PostServiceEditPostResult) InitDefault() {
+func (p *SchoolServiceGetAllSchoolsResult) InitDefault() {
 }
 
-var PostServiceEditPostResult_Success_DEFAULT *post.EditPostResp
+var SchoolServiceGetAllSchoolsResult_Success_DEFAULT *school.GetAllSchoolsResp
 
-func (p *PostServiceEditPostResult) GetSuccess() (v *post.EditPostResp) {
+func (p *SchoolServiceGetAllSchoolsResult) GetSuccess() (v *school.GetAllSchoolsResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceEditPostResult_Success_DEFAULT
+		return SchoolServiceGetAllSchoolsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceEditPostResult = map[int16]string{
+var fieldIDToName_SchoolServiceGetAllSchoolsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceEditPostResult) IsSetSuccess() bool {
+func (p *SchoolServiceGetAllSchoolsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceEditPostResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *SchoolServiceGetAllSchoolsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7150,7 +20300,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceEditPostResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SchoolServiceGetAllSchoolsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7160,8 +20310,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceEditPostResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewEditPostResp()
+func (p *SchoolServiceGetAllSchoolsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := school.NewGetAllSchoolsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7169,9 +20319,9 @@ func (p *PostServiceEditPostResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceEditPostResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *SchoolServiceGetAllSchoolsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("EditPost_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllSchools_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7197,62 +20347,151 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceEditPostResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+func (p *SchoolServiceGetAllSchoolsResult) writeField0(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSuccess() {
+		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+			goto WriteFieldBeginError
+		}
+		if err := p.Success.Write(oprot); err != nil {
+			return err
+		}
+		if err = oprot.WriteFieldEnd(); err != nil {
+			goto WriteFieldEndError
+		}
+	}
+	return nil
+WriteFieldBeginError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
+WriteFieldEndError:
+	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+}
+
+func (p *SchoolServiceGetAllSchoolsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SchoolServiceGetAllSchoolsResult(%+v)", *p)
+
+}
+
+type CategoryServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      CategoryService
+}
+
+func (p *CategoryServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
+}
+
+func (p *CategoryServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
+}
+
+func (p *CategoryServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
+}
+
+func NewCategoryServiceProcessor(handler CategoryService) *CategoryServiceProcessor {
+	self := &CategoryServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetAllCategories", &categoryServiceProcessorGetAllCategories{handler: handler})
+	return self
+}
+func (p *CategoryServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
+	}
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
+	}
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
+}
+
+type categoryServiceProcessorGetAllCategories struct {
+	handler CategoryService
 }
 
-func (p *PostServiceEditPostResult) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *categoryServiceProcessorGetAllCategories) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CategoryServiceGetAllCategoriesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetAllCategories", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return fmt.Sprintf("PostServiceEditPostResult(%+v)", *p)
 
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CategoryServiceGetAllCategoriesResult{}
+	var retval *category.GetAllCategoriesResp
+	if retval, err2 = p.handler.GetAllCategories(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllCategories: "+err2.Error())
+		oprot.WriteMessageBegin("GetAllCategories", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetAllCategories", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type PostServiceDeletePostArgs struct {
-	Request *post.DeletePostReq `thrift:"request,1"`
+type CategoryServiceGetAllCategoriesArgs struct {
+	Request *category.GetAllCategoriesReq `thrift:"request,1"`
 }
 
-func NewPostServiceDeletePostArgs() *PostServiceDeletePostArgs {
-	return &PostServiceDeletePostArgs{}
+func NewCategoryServiceGetAllCategoriesArgs() *CategoryServiceGetAllCategoriesArgs {
+	return &CategoryServiceGetAllCategoriesArgs{}
 }
 
-func (p *PostServiceDeletePostArgs) InitDefault() {
+func (p *CategoryServiceGetAllCategoriesArgs) InitDefault() {
 }
 
-var PostServiceDeletePostArgs_Request_DEFAULT *post.DeletePostReq
+var CategoryServiceGetAllCategoriesArgs_Request_DEFAULT *category.GetAllCategoriesReq
 
-func (p *PostServiceDeletePostArgs) GetRequest() (v *post.DeletePostReq) {
+func (p *CategoryServiceGetAllCategoriesArgs) GetRequest() (v *category.GetAllCategoriesReq) {
 	if !p.IsSetRequest() {
-		return PostServiceDeletePostArgs_Request_DEFAULT
+		return CategoryServiceGetAllCategoriesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceDeletePostArgs = map[int16]string{
+var fieldIDToName_CategoryServiceGetAllCategoriesArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceDeletePostArgs) IsSetRequest() bool {
+func (p *CategoryServiceGetAllCategoriesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceDeletePostArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CategoryServiceGetAllCategoriesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7298,7 +20537,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceDeletePostArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CategoryServiceGetAllCategoriesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7308,8 +20547,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceDeletePostArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewDeletePostReq()
+func (p *CategoryServiceGetAllCategoriesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := category.NewGetAllCategoriesReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7317,9 +20556,9 @@ func (p *PostServiceDeletePostArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceDeletePostArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CategoryServiceGetAllCategoriesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeletePost_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllCategories_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7345,7 +20584,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceDeletePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CategoryServiceGetAllCategoriesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7362,43 +20601,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceDeletePostArgs) String() string {
+func (p *CategoryServiceGetAllCategoriesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceDeletePostArgs(%+v)", *p)
+	return fmt.Sprintf("CategoryServiceGetAllCategoriesArgs(%+v)", *p)
 
 }
 
-type PostServiceDeletePostResult struct {
-	Success *post.DeletePostResp `thrift:"success,0,optional"`
+type CategoryServiceGetAllCategoriesResult struct {
+	Success *category.GetAllCategoriesResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceDeletePostResult() *PostServiceDeletePostResult {
-	return &PostServiceDeletePostResult{}
+func NewCategoryServiceGetAllCategoriesResult() *CategoryServiceGetAllCategoriesResult {
+	return &CategoryServiceGetAllCategoriesResult{}
 }
 
-func (p *PostServiceDeletePostResult) InitDefault() {
+func (p *CategoryServiceGetAllCategoriesResult) InitDefault() {
 }
 
-var PostServiceDeletePostResult_Success_DEFAULT *post.DeletePostResp
+var CategoryServiceGetAllCategoriesResult_Success_DEFAULT *category.GetAllCategoriesResp
 
-func (p *PostServiceDeletePostResult) GetSuccess() (v *post.DeletePostResp) {
+func (p *CategoryServiceGetAllCategoriesResult) GetSuccess() (v *category.GetAllCategoriesResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceDeletePostResult_Success_DEFAULT
+		return CategoryServiceGetAllCategoriesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceDeletePostResult = map[int16]string{
+var fieldIDToName_CategoryServiceGetAllCategoriesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceDeletePostResult) IsSetSuccess() bool {
+func (p *CategoryServiceGetAllCategoriesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceDeletePostResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CategoryServiceGetAllCategoriesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7444,7 +20683,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceDeletePostResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CategoryServiceGetAllCategoriesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7454,8 +20693,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceDeletePostResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewDeletePostResp()
+func (p *CategoryServiceGetAllCategoriesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := category.NewGetAllCategoriesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7463,9 +20702,9 @@ func (p *PostServiceDeletePostResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceDeletePostResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CategoryServiceGetAllCategoriesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("DeletePost_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetAllCategories_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7491,7 +20730,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceDeletePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CategoryServiceGetAllCategoriesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -7510,337 +20749,377 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceDeletePostResult) String() string {
+func (p *CategoryServiceGetAllCategoriesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceDeletePostResult(%+v)", *p)
-
-}
+	return fmt.Sprintf("CategoryServiceGetAllCategoriesResult(%+v)", *p)
 
-type PostServiceGetSchoolRecentPostsArgs struct {
-	Request *post.GetSchoolRecentPostsReq `thrift:"request,1"`
 }
 
-func NewPostServiceGetSchoolRecentPostsArgs() *PostServiceGetSchoolRecentPostsArgs {
-	return &PostServiceGetSchoolRecentPostsArgs{}
+type CommentServiceProcessor struct {
+	processorMap map[string]thrift.TProcessorFunction
+	handler      CommentService
 }
 
-func (p *PostServiceGetSchoolRecentPostsArgs) InitDefault() {
+func (p *CommentServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+	p.processorMap[key] = processor
 }
 
-var PostServiceGetSchoolRecentPostsArgs_Request_DEFAULT *post.GetSchoolRecentPostsReq
-
-func (p *PostServiceGetSchoolRecentPostsArgs) GetRequest() (v *post.GetSchoolRecentPostsReq) {
-	if !p.IsSetRequest() {
-		return PostServiceGetSchoolRecentPostsArgs_Request_DEFAULT
-	}
-	return p.Request
+func (p *CommentServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+	processor, ok = p.processorMap[key]
+	return processor, ok
 }
 
-var fieldIDToName_PostServiceGetSchoolRecentPostsArgs = map[int16]string{
-	1: "request",
+func (p *CommentServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+	return p.processorMap
 }
 
-func (p *PostServiceGetSchoolRecentPostsArgs) IsSetRequest() bool {
-	return p.Request != nil
+func NewCommentServiceProcessor(handler CommentService) *CommentServiceProcessor {
+	self := &CommentServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("CreateComment", &commentServiceProcessorCreateComment{handler: handler})
+	self.AddToProcessorMap("DeleteComment", &commentServiceProcessorDeleteComment{handler: handler})
+	self.AddToProcessorMap("GetPostComments", &commentServiceProcessorGetPostComments{handler: handler})
+	self.AddToProcessorMap("GetCommentReplies", &commentServiceProcessorGetCommentReplies{handler: handler})
+	self.AddToProcessorMap("LikeComment", &commentServiceProcessorLikeComment{handler: handler})
+	self.AddToProcessorMap("UnlikeComment", &commentServiceProcessorUnlikeComment{handler: handler})
+	return self
 }
-
-func (p *PostServiceGetSchoolRecentPostsArgs) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
-	}
-
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 1:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField1(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+func (p *CommentServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	name, _, seqId, err := iprot.ReadMessageBegin()
+	if err != nil {
+		return false, err
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if processor, ok := p.GetProcessorFunction(name); ok {
+		return processor.Process(ctx, seqId, iprot, oprot)
 	}
-
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetSchoolRecentPostsArgs[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	iprot.Skip(thrift.STRUCT)
+	iprot.ReadMessageEnd()
+	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
+	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+	x.Write(oprot)
+	oprot.WriteMessageEnd()
+	oprot.Flush(ctx)
+	return false, x
 }
 
-func (p *PostServiceGetSchoolRecentPostsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewGetSchoolRecentPostsReq()
-	if err := _field.Read(iprot); err != nil {
-		return err
-	}
-	p.Request = _field
-	return nil
+type commentServiceProcessorCreateComment struct {
+	handler CommentService
 }
 
-func (p *PostServiceGetSchoolRecentPostsArgs) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSchoolRecentPosts_args"); err != nil {
-		goto WriteStructBeginError
+func (p *commentServiceProcessorCreateComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceCreateCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("CreateComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	if p != nil {
-		if err = p.writeField1(oprot); err != nil {
-			fieldId = 1
-			goto WriteFieldError
-		}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceCreateCommentResult{}
+	var retval *comment.CreateCommentResp
+	if retval, err2 = p.handler.CreateComment(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CreateComment: "+err2.Error())
+		oprot.WriteMessageBegin("CreateComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+	if err2 = oprot.WriteMessageBegin("CreateComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
-}
-
-func (p *PostServiceGetSchoolRecentPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
-	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
-		goto WriteFieldBeginError
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
 	}
-	if err := p.Request.Write(oprot); err != nil {
-		return err
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteFieldEnd(); err != nil {
-		goto WriteFieldEndError
+	if err != nil {
+		return
 	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
+	return true, err
 }
 
-func (p *PostServiceGetSchoolRecentPostsArgs) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("PostServiceGetSchoolRecentPostsArgs(%+v)", *p)
-
+type commentServiceProcessorDeleteComment struct {
+	handler CommentService
 }
 
-type PostServiceGetSchoolRecentPostsResult struct {
-	Success *post.GetSchoolRecentPostsResp `thrift:"success,0,optional"`
-}
+func (p *commentServiceProcessorDeleteComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceDeleteCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("DeleteComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
 
-func NewPostServiceGetSchoolRecentPostsResult() *PostServiceGetSchoolRecentPostsResult {
-	return &PostServiceGetSchoolRecentPostsResult{}
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceDeleteCommentResult{}
+	var retval *comment.DeleteCommentResp
+	if retval, err2 = p.handler.DeleteComment(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeleteComment: "+err2.Error())
+		oprot.WriteMessageBegin("DeleteComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("DeleteComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func (p *PostServiceGetSchoolRecentPostsResult) InitDefault() {
+type commentServiceProcessorGetPostComments struct {
+	handler CommentService
 }
 
-var PostServiceGetSchoolRecentPostsResult_Success_DEFAULT *post.GetSchoolRecentPostsResp
-
-func (p *PostServiceGetSchoolRecentPostsResult) GetSuccess() (v *post.GetSchoolRecentPostsResp) {
-	if !p.IsSetSuccess() {
-		return PostServiceGetSchoolRecentPostsResult_Success_DEFAULT
+func (p *commentServiceProcessorGetPostComments) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceGetPostCommentsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetPostComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return p.Success
-}
 
-var fieldIDToName_PostServiceGetSchoolRecentPostsResult = map[int16]string{
-	0: "success",
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceGetPostCommentsResult{}
+	var retval *comment.GetPostCommentsResp
+	if retval, err2 = p.handler.GetPostComments(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPostComments: "+err2.Error())
+		oprot.WriteMessageBegin("GetPostComments", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetPostComments", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func (p *PostServiceGetSchoolRecentPostsResult) IsSetSuccess() bool {
-	return p.Success != nil
+type commentServiceProcessorGetCommentReplies struct {
+	handler CommentService
 }
 
-func (p *PostServiceGetSchoolRecentPostsResult) Read(iprot thrift.TProtocol) (err error) {
-
-	var fieldTypeId thrift.TType
-	var fieldId int16
-
-	if _, err = iprot.ReadStructBegin(); err != nil {
-		goto ReadStructBeginError
+func (p *commentServiceProcessorGetCommentReplies) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceGetCommentRepliesArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetCommentReplies", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
 
-	for {
-		_, fieldTypeId, fieldId, err = iprot.ReadFieldBegin()
-		if err != nil {
-			goto ReadFieldBeginError
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-
-		switch fieldId {
-		case 0:
-			if fieldTypeId == thrift.STRUCT {
-				if err = p.ReadField0(iprot); err != nil {
-					goto ReadFieldError
-				}
-			} else if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		default:
-			if err = iprot.Skip(fieldTypeId); err != nil {
-				goto SkipFieldError
-			}
-		}
-		if err = iprot.ReadFieldEnd(); err != nil {
-			goto ReadFieldEndError
-		}
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceGetCommentRepliesResult{}
+	var retval *comment.GetCommentRepliesResp
+	if retval, err2 = p.handler.GetCommentReplies(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetCommentReplies: "+err2.Error())
+		oprot.WriteMessageBegin("GetCommentReplies", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
 	}
-	if err = iprot.ReadStructEnd(); err != nil {
-		goto ReadStructEndError
+	if err2 = oprot.WriteMessageBegin("GetCommentReplies", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
+}
 
-	return nil
-ReadStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct begin error: ", p), err)
-ReadFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
-ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetSchoolRecentPostsResult[fieldId]), err)
-SkipFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
-
-ReadFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read field end error", p), err)
-ReadStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+type commentServiceProcessorLikeComment struct {
+	handler CommentService
 }
 
-func (p *PostServiceGetSchoolRecentPostsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewGetSchoolRecentPostsResp()
-	if err := _field.Read(iprot); err != nil {
-		return err
+func (p *commentServiceProcessorLikeComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceLikeCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("LikeComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	p.Success = _field
-	return nil
-}
 
-func (p *PostServiceGetSchoolRecentPostsResult) Write(oprot thrift.TProtocol) (err error) {
-	var fieldId int16
-	if err = oprot.WriteStructBegin("GetSchoolRecentPosts_result"); err != nil {
-		goto WriteStructBeginError
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceLikeCommentResult{}
+	var retval *comment.UserFlagCommentResp
+	if retval, err2 = p.handler.LikeComment(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LikeComment: "+err2.Error())
+		oprot.WriteMessageBegin("LikeComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
 	}
-	if p != nil {
-		if err = p.writeField0(oprot); err != nil {
-			fieldId = 0
-			goto WriteFieldError
-		}
+	if err2 = oprot.WriteMessageBegin("LikeComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteFieldStop(); err != nil {
-		goto WriteFieldStopError
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
 	}
-	if err = oprot.WriteStructEnd(); err != nil {
-		goto WriteStructEndError
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
 	}
-	return nil
-WriteStructBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-WriteFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T write field %d error: ", p, fieldId), err)
-WriteFieldStopError:
-	return thrift.PrependError(fmt.Sprintf("%T write field stop error: ", p), err)
-WriteStructEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
+	return true, err
 }
 
-func (p *PostServiceGetSchoolRecentPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSuccess() {
-		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-			goto WriteFieldBeginError
-		}
-		if err := p.Success.Write(oprot); err != nil {
-			return err
-		}
-		if err = oprot.WriteFieldEnd(); err != nil {
-			goto WriteFieldEndError
-		}
-	}
-	return nil
-WriteFieldBeginError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 begin error: ", p), err)
-WriteFieldEndError:
-	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
+type commentServiceProcessorUnlikeComment struct {
+	handler CommentService
 }
 
-func (p *PostServiceGetSchoolRecentPostsResult) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *commentServiceProcessorUnlikeComment) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := CommentServiceUnlikeCommentArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("UnlikeComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
 	}
-	return fmt.Sprintf("PostServiceGetSchoolRecentPostsResult(%+v)", *p)
 
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := CommentServiceUnlikeCommentResult{}
+	var retval *comment.UserFlagCommentResp
+	if retval, err2 = p.handler.UnlikeComment(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UnlikeComment: "+err2.Error())
+		oprot.WriteMessageBegin("UnlikeComment", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("UnlikeComment", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-type PostServiceGetPersonalRecentPostsArgs struct {
-	Request *post.GetPersonalRecentPostsResp `thrift:"request,1"`
+type CommentServiceCreateCommentArgs struct {
+	Request *comment.CreateCommentReq `thrift:"request,1"`
 }
 
-func NewPostServiceGetPersonalRecentPostsArgs() *PostServiceGetPersonalRecentPostsArgs {
-	return &PostServiceGetPersonalRecentPostsArgs{}
+func NewCommentServiceCreateCommentArgs() *CommentServiceCreateCommentArgs {
+	return &CommentServiceCreateCommentArgs{}
 }
 
-func (p *PostServiceGetPersonalRecentPostsArgs) InitDefault() {
+func (p *CommentServiceCreateCommentArgs) InitDefault() {
 }
 
-var PostServiceGetPersonalRecentPostsArgs_Request_DEFAULT *post.GetPersonalRecentPostsResp
+var CommentServiceCreateCommentArgs_Request_DEFAULT *comment.CreateCommentReq
 
-func (p *PostServiceGetPersonalRecentPostsArgs) GetRequest() (v *post.GetPersonalRecentPostsResp) {
+func (p *CommentServiceCreateCommentArgs) GetRequest() (v *comment.CreateCommentReq) {
 	if !p.IsSetRequest() {
-		return PostServiceGetPersonalRecentPostsArgs_Request_DEFAULT
+		return CommentServiceCreateCommentArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceGetPersonalRecentPostsArgs = map[int16]string{
+var fieldIDToName_CommentServiceCreateCommentArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceGetPersonalRecentPostsArgs) IsSetRequest() bool {
+func (p *CommentServiceCreateCommentArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceGetPersonalRecentPostsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCreateCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -7886,7 +21165,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPersonalRecentPostsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCreateCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -7896,8 +21175,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceGetPersonalRecentPostsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewGetPersonalRecentPostsResp()
+func (p *CommentServiceCreateCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := comment.NewCreateCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -7905,9 +21184,9 @@ func (p *PostServiceGetPersonalRecentPostsArgs) ReadField1(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *PostServiceGetPersonalRecentPostsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCreateCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPersonalRecentPosts_args"); err != nil {
+	if err = oprot.WriteStructBegin("CreateComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -7933,7 +21212,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceGetPersonalRecentPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCreateCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -7950,43 +21229,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceGetPersonalRecentPostsArgs) String() string {
+func (p *CommentServiceCreateCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceGetPersonalRecentPostsArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceCreateCommentArgs(%+v)", *p)
 
 }
 
-type PostServiceGetPersonalRecentPostsResult struct {
-	Success *post.GetPersonalRecentPostsReq `thrift:"success,0,optional"`
+type CommentServiceCreateCommentResult struct {
+	Success *comment.CreateCommentResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceGetPersonalRecentPostsResult() *PostServiceGetPersonalRecentPostsResult {
-	return &PostServiceGetPersonalRecentPostsResult{}
+func NewCommentServiceCreateCommentResult() *CommentServiceCreateCommentResult {
+	return &CommentServiceCreateCommentResult{}
 }
 
-func (p *PostServiceGetPersonalRecentPostsResult) InitDefault() {
+func (p *CommentServiceCreateCommentResult) InitDefault() {
 }
 
-var PostServiceGetPersonalRecentPostsResult_Success_DEFAULT *post.GetPersonalRecentPostsReq
+var CommentServiceCreateCommentResult_Success_DEFAULT *comment.CreateCommentResp
 
-func (p *PostServiceGetPersonalRecentPostsResult) GetSuccess() (v *post.GetPersonalRecentPostsReq) {
+func (p *CommentServiceCreateCommentResult) GetSuccess() (v *comment.CreateCommentResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceGetPersonalRecentPostsResult_Success_DEFAULT
+		return CommentServiceCreateCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceGetPersonalRecentPostsResult = map[int16]string{
+var fieldIDToName_CommentServiceCreateCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceGetPersonalRecentPostsResult) IsSetSuccess() bool {
+func (p *CommentServiceCreateCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceGetPersonalRecentPostsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCreateCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8032,7 +21311,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceGetPersonalRecentPostsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceCreateCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8042,8 +21321,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceGetPersonalRecentPostsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewGetPersonalRecentPostsReq()
+func (p *CommentServiceCreateCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := comment.NewCreateCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8051,9 +21330,9 @@ func (p *PostServiceGetPersonalRecentPostsResult) ReadField0(iprot thrift.TProto
 	return nil
 }
 
-func (p *PostServiceGetPersonalRecentPostsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCreateCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetPersonalRecentPosts_result"); err != nil {
+	if err = oprot.WriteStructBegin("CreateComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8079,7 +21358,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceGetPersonalRecentPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceCreateCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8098,43 +21377,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceGetPersonalRecentPostsResult) String() string {
+func (p *CommentServiceCreateCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceGetPersonalRecentPostsResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceCreateCommentResult(%+v)", *p)
 
 }
 
-type PostServiceLikePostArgs struct {
-	Request *post.UserFlagPostResq `thrift:"request,1"`
+type CommentServiceDeleteCommentArgs struct {
+	Request *comment.DeleteCommentReq `thrift:"request,1"`
 }
 
-func NewPostServiceLikePostArgs() *PostServiceLikePostArgs {
-	return &PostServiceLikePostArgs{}
+func NewCommentServiceDeleteCommentArgs() *CommentServiceDeleteCommentArgs {
+	return &CommentServiceDeleteCommentArgs{}
 }
 
-func (p *PostServiceLikePostArgs) InitDefault() {
+func (p *CommentServiceDeleteCommentArgs) InitDefault() {
 }
 
-var PostServiceLikePostArgs_Request_DEFAULT *post.UserFlagPostResq
+var CommentServiceDeleteCommentArgs_Request_DEFAULT *comment.DeleteCommentReq
 
-func (p *PostServiceLikePostArgs) GetRequest() (v *post.UserFlagPostResq) {
+func (p *CommentServiceDeleteCommentArgs) GetRequest() (v *comment.DeleteCommentReq) {
 	if !p.IsSetRequest() {
-		return PostServiceLikePostArgs_Request_DEFAULT
+		return CommentServiceDeleteCommentArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceLikePostArgs = map[int16]string{
+var fieldIDToName_CommentServiceDeleteCommentArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceLikePostArgs) IsSetRequest() bool {
+func (p *CommentServiceDeleteCommentArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceLikePostArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceDeleteCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8180,7 +21459,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceLikePostArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceDeleteCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8190,8 +21469,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceLikePostArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewUserFlagPostResq()
+func (p *CommentServiceDeleteCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := comment.NewDeleteCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8199,9 +21478,9 @@ func (p *PostServiceLikePostArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceLikePostArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceDeleteCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LikePost_args"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8227,7 +21506,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceLikePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceDeleteCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8244,43 +21523,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceLikePostArgs) String() string {
+func (p *CommentServiceDeleteCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceLikePostArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceDeleteCommentArgs(%+v)", *p)
 
 }
 
-type PostServiceLikePostResult struct {
-	Success *post.LikePostReq `thrift:"success,0,optional"`
+type CommentServiceDeleteCommentResult struct {
+	Success *comment.DeleteCommentResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceLikePostResult() *PostServiceLikePostResult {
-	return &PostServiceLikePostResult{}
+func NewCommentServiceDeleteCommentResult() *CommentServiceDeleteCommentResult {
+	return &CommentServiceDeleteCommentResult{}
 }
 
-func (p *PostServiceLikePostResult) InitDefault() {
+func (p *CommentServiceDeleteCommentResult) InitDefault() {
 }
 
-var PostServiceLikePostResult_Success_DEFAULT *post.LikePostReq
+var CommentServiceDeleteCommentResult_Success_DEFAULT *comment.DeleteCommentResp
 
-func (p *PostServiceLikePostResult) GetSuccess() (v *post.LikePostReq) {
+func (p *CommentServiceDeleteCommentResult) GetSuccess() (v *comment.DeleteCommentResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceLikePostResult_Success_DEFAULT
+		return CommentServiceDeleteCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceLikePostResult = map[int16]string{
+var fieldIDToName_CommentServiceDeleteCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceLikePostResult) IsSetSuccess() bool {
+func (p *CommentServiceDeleteCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceLikePostResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceDeleteCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8326,7 +21605,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceLikePostResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceDeleteCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8336,8 +21615,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceLikePostResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewLikePostReq()
+func (p *CommentServiceDeleteCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := comment.NewDeleteCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8345,9 +21624,9 @@ func (p *PostServiceLikePostResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceLikePostResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceDeleteCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("LikePost_result"); err != nil {
+	if err = oprot.WriteStructBegin("DeleteComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8373,7 +21652,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceLikePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceDeleteCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8392,43 +21671,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceLikePostResult) String() string {
+func (p *CommentServiceDeleteCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceLikePostResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceDeleteCommentResult(%+v)", *p)
 
 }
 
-type PostServiceUnlikePostArgs struct {
-	Request *post.UserFlagPostResq `thrift:"request,1"`
+type CommentServiceGetPostCommentsArgs struct {
+	Request *comment.GetPostCommentsReq `thrift:"request,1"`
 }
 
-func NewPostServiceUnlikePostArgs() *PostServiceUnlikePostArgs {
-	return &PostServiceUnlikePostArgs{}
+func NewCommentServiceGetPostCommentsArgs() *CommentServiceGetPostCommentsArgs {
+	return &CommentServiceGetPostCommentsArgs{}
 }
 
-func (p *PostServiceUnlikePostArgs) InitDefault() {
+func (p *CommentServiceGetPostCommentsArgs) InitDefault() {
 }
 
-var PostServiceUnlikePostArgs_Request_DEFAULT *post.UserFlagPostResq
+var CommentServiceGetPostCommentsArgs_Request_DEFAULT *comment.GetPostCommentsReq
 
-func (p *PostServiceUnlikePostArgs) GetRequest() (v *post.UserFlagPostResq) {
+func (p *CommentServiceGetPostCommentsArgs) GetRequest() (v *comment.GetPostCommentsReq) {
 	if !p.IsSetRequest() {
-		return PostServiceUnlikePostArgs_Request_DEFAULT
+		return CommentServiceGetPostCommentsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceUnlikePostArgs = map[int16]string{
+var fieldIDToName_CommentServiceGetPostCommentsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceUnlikePostArgs) IsSetRequest() bool {
+func (p *CommentServiceGetPostCommentsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceUnlikePostArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetPostCommentsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8474,7 +21753,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnlikePostArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceGetPostCommentsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8484,8 +21763,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceUnlikePostArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewUserFlagPostResq()
+func (p *CommentServiceGetPostCommentsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := comment.NewGetPostCommentsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8493,9 +21772,9 @@ func (p *PostServiceUnlikePostArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceUnlikePostArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetPostCommentsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UnlikePost_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetPostComments_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8521,7 +21800,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceUnlikePostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetPostCommentsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8538,43 +21817,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceUnlikePostArgs) String() string {
+func (p *CommentServiceGetPostCommentsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceUnlikePostArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceGetPostCommentsArgs(%+v)", *p)
 
 }
 
-type PostServiceUnlikePostResult struct {
-	Success *post.UnlikePostReq `thrift:"success,0,optional"`
+type CommentServiceGetPostCommentsResult struct {
+	Success *comment.GetPostCommentsResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceUnlikePostResult() *PostServiceUnlikePostResult {
-	return &PostServiceUnlikePostResult{}
+func NewCommentServiceGetPostCommentsResult() *CommentServiceGetPostCommentsResult {
+	return &CommentServiceGetPostCommentsResult{}
 }
 
-func (p *PostServiceUnlikePostResult) InitDefault() {
+func (p *CommentServiceGetPostCommentsResult) InitDefault() {
 }
 
-var PostServiceUnlikePostResult_Success_DEFAULT *post.UnlikePostReq
+var CommentServiceGetPostCommentsResult_Success_DEFAULT *comment.GetPostCommentsResp
 
-func (p *PostServiceUnlikePostResult) GetSuccess() (v *post.UnlikePostReq) {
+func (p *CommentServiceGetPostCommentsResult) GetSuccess() (v *comment.GetPostCommentsResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceUnlikePostResult_Success_DEFAULT
+		return CommentServiceGetPostCommentsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceUnlikePostResult = map[int16]string{
+var fieldIDToName_CommentServiceGetPostCommentsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceUnlikePostResult) IsSetSuccess() bool {
+func (p *CommentServiceGetPostCommentsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceUnlikePostResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetPostCommentsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8620,7 +21899,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnlikePostResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceGetPostCommentsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8630,8 +21909,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceUnlikePostResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewUnlikePostReq()
+func (p *CommentServiceGetPostCommentsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := comment.NewGetPostCommentsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8639,9 +21918,9 @@ func (p *PostServiceUnlikePostResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceUnlikePostResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetPostCommentsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UnlikePost_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetPostComments_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8667,7 +21946,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceUnlikePostResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetPostCommentsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8686,43 +21965,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceUnlikePostResult) String() string {
+func (p *CommentServiceGetPostCommentsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceUnlikePostResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceGetPostCommentsResult(%+v)", *p)
 
 }
 
-type PostServiceFavPostArgs struct {
-	Request *post.UserFlagPostResq `thrift:"request,1"`
+type CommentServiceGetCommentRepliesArgs struct {
+	Request *comment.GetCommentRepliesReq `thrift:"request,1"`
 }
 
-func NewPostServiceFavPostArgs() *PostServiceFavPostArgs {
-	return &PostServiceFavPostArgs{}
+func NewCommentServiceGetCommentRepliesArgs() *CommentServiceGetCommentRepliesArgs {
+	return &CommentServiceGetCommentRepliesArgs{}
 }
 
-func (p *PostServiceFavPostArgs) InitDefault() {
+func (p *CommentServiceGetCommentRepliesArgs) InitDefault() {
 }
 
-var PostServiceFavPostArgs_Request_DEFAULT *post.UserFlagPostResq
+var CommentServiceGetCommentRepliesArgs_Request_DEFAULT *comment.GetCommentRepliesReq
 
-func (p *PostServiceFavPostArgs) GetRequest() (v *post.UserFlagPostResq) {
+func (p *CommentServiceGetCommentRepliesArgs) GetRequest() (v *comment.GetCommentRepliesReq) {
 	if !p.IsSetRequest() {
-		return PostServiceFavPostArgs_Request_DEFAULT
+		return CommentServiceGetCommentRepliesArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceFavPostArgs = map[int16]string{
+var fieldIDToName_CommentServiceGetCommentRepliesArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceFavPostArgs) IsSetRequest() bool {
+func (p *CommentServiceGetCommentRepliesArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceFavPostArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetCommentRepliesArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8768,7 +22047,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceFavPostArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceGetCommentRepliesArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8778,8 +22057,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceFavPostArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewUserFlagPostResq()
+func (p *CommentServiceGetCommentRepliesArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := comment.NewGetCommentRepliesReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8787,9 +22066,9 @@ func (p *PostServiceFavPostArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceFavPostArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetCommentRepliesArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FavPost_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetCommentReplies_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8815,7 +22094,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceFavPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetCommentRepliesArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -8832,43 +22111,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceFavPostArgs) String() string {
+func (p *CommentServiceGetCommentRepliesArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceFavPostArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceGetCommentRepliesArgs(%+v)", *p)
 
 }
 
-type PostServiceFavPostResult struct {
-	Success *post.FavPostReq `thrift:"success,0,optional"`
+type CommentServiceGetCommentRepliesResult struct {
+	Success *comment.GetCommentRepliesResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceFavPostResult() *PostServiceFavPostResult {
-	return &PostServiceFavPostResult{}
+func NewCommentServiceGetCommentRepliesResult() *CommentServiceGetCommentRepliesResult {
+	return &CommentServiceGetCommentRepliesResult{}
 }
 
-func (p *PostServiceFavPostResult) InitDefault() {
+func (p *CommentServiceGetCommentRepliesResult) InitDefault() {
 }
 
-var PostServiceFavPostResult_Success_DEFAULT *post.FavPostReq
+var CommentServiceGetCommentRepliesResult_Success_DEFAULT *comment.GetCommentRepliesResp
 
-func (p *PostServiceFavPostResult) GetSuccess() (v *post.FavPostReq) {
+func (p *CommentServiceGetCommentRepliesResult) GetSuccess() (v *comment.GetCommentRepliesResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceFavPostResult_Success_DEFAULT
+		return CommentServiceGetCommentRepliesResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceFavPostResult = map[int16]string{
+var fieldIDToName_CommentServiceGetCommentRepliesResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceFavPostResult) IsSetSuccess() bool {
+func (p *CommentServiceGetCommentRepliesResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceFavPostResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetCommentRepliesResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -8914,7 +22193,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceFavPostResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceGetCommentRepliesResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -8924,8 +22203,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceFavPostResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewFavPostReq()
+func (p *CommentServiceGetCommentRepliesResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := comment.NewGetCommentRepliesResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -8933,9 +22212,9 @@ func (p *PostServiceFavPostResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceFavPostResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetCommentRepliesResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("FavPost_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetCommentReplies_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -8961,7 +22240,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceFavPostResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceGetCommentRepliesResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -8980,43 +22259,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceFavPostResult) String() string {
+func (p *CommentServiceGetCommentRepliesResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceFavPostResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceGetCommentRepliesResult(%+v)", *p)
 
 }
 
-type PostServiceUnfavPostArgs struct {
-	Request *post.UserFlagPostResq `thrift:"request,1"`
+type CommentServiceLikeCommentArgs struct {
+	Request *comment.LikeCommentReq `thrift:"request,1"`
 }
 
-func NewPostServiceUnfavPostArgs() *PostServiceUnfavPostArgs {
-	return &PostServiceUnfavPostArgs{}
+func NewCommentServiceLikeCommentArgs() *CommentServiceLikeCommentArgs {
+	return &CommentServiceLikeCommentArgs{}
 }
 
-func (p *PostServiceUnfavPostArgs) InitDefault() {
+func (p *CommentServiceLikeCommentArgs) InitDefault() {
 }
 
-var PostServiceUnfavPostArgs_Request_DEFAULT *post.UserFlagPostResq
+var CommentServiceLikeCommentArgs_Request_DEFAULT *comment.LikeCommentReq
 
-func (p *PostServiceUnfavPostArgs) GetRequest() (v *post.UserFlagPostResq) {
+func (p *CommentServiceLikeCommentArgs) GetRequest() (v *comment.LikeCommentReq) {
 	if !p.IsSetRequest() {
-		return PostServiceUnfavPostArgs_Request_DEFAULT
+		return CommentServiceLikeCommentArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceUnfavPostArgs = map[int16]string{
+var fieldIDToName_CommentServiceLikeCommentArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceUnfavPostArgs) IsSetRequest() bool {
+func (p *CommentServiceLikeCommentArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceUnfavPostArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceLikeCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9062,7 +22341,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnfavPostArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceLikeCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9072,8 +22351,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceUnfavPostArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewUserFlagPostResq()
+func (p *CommentServiceLikeCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := comment.NewLikeCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9081,9 +22360,9 @@ func (p *PostServiceUnfavPostArgs) ReadField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceUnfavPostArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceLikeCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UnfavPost_args"); err != nil {
+	if err = oprot.WriteStructBegin("LikeComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9109,7 +22388,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceUnfavPostArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceLikeCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9126,43 +22405,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceUnfavPostArgs) String() string {
+func (p *CommentServiceLikeCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceUnfavPostArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceLikeCommentArgs(%+v)", *p)
 
 }
 
-type PostServiceUnfavPostResult struct {
-	Success *post.UnfavPostReq `thrift:"success,0,optional"`
+type CommentServiceLikeCommentResult struct {
+	Success *comment.UserFlagCommentResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceUnfavPostResult() *PostServiceUnfavPostResult {
-	return &PostServiceUnfavPostResult{}
+func NewCommentServiceLikeCommentResult() *CommentServiceLikeCommentResult {
+	return &CommentServiceLikeCommentResult{}
 }
 
-func (p *PostServiceUnfavPostResult) InitDefault() {
+func (p *CommentServiceLikeCommentResult) InitDefault() {
 }
 
-var PostServiceUnfavPostResult_Success_DEFAULT *post.UnfavPostReq
+var CommentServiceLikeCommentResult_Success_DEFAULT *comment.UserFlagCommentResp
 
-func (p *PostServiceUnfavPostResult) GetSuccess() (v *post.UnfavPostReq) {
+func (p *CommentServiceLikeCommentResult) GetSuccess() (v *comment.UserFlagCommentResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceUnfavPostResult_Success_DEFAULT
+		return CommentServiceLikeCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceUnfavPostResult = map[int16]string{
+var fieldIDToName_CommentServiceLikeCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceUnfavPostResult) IsSetSuccess() bool {
+func (p *CommentServiceLikeCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceUnfavPostResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceLikeCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9208,7 +22487,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUnfavPostResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceLikeCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9218,8 +22497,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceUnfavPostResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewUnfavPostReq()
+func (p *CommentServiceLikeCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := comment.NewUserFlagCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9227,9 +22506,9 @@ func (p *PostServiceUnfavPostResult) ReadField0(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PostServiceUnfavPostResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceLikeCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UnfavPost_result"); err != nil {
+	if err = oprot.WriteStructBegin("LikeComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9255,7 +22534,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceUnfavPostResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceLikeCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9274,43 +22553,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceUnfavPostResult) String() string {
+func (p *CommentServiceLikeCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceUnfavPostResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceLikeCommentResult(%+v)", *p)
 
 }
 
-type PostServiceUploadPostMediaArgs struct {
-	Request *post.UploadPostMediaReq `thrift:"request,1"`
+type CommentServiceUnlikeCommentArgs struct {
+	Request *comment.UnlikeCommentReq `thrift:"request,1"`
 }
 
-func NewPostServiceUploadPostMediaArgs() *PostServiceUploadPostMediaArgs {
-	return &PostServiceUploadPostMediaArgs{}
+func NewCommentServiceUnlikeCommentArgs() *CommentServiceUnlikeCommentArgs {
+	return &CommentServiceUnlikeCommentArgs{}
 }
 
-func (p *PostServiceUploadPostMediaArgs) InitDefault() {
+func (p *CommentServiceUnlikeCommentArgs) InitDefault() {
 }
 
-var PostServiceUploadPostMediaArgs_Request_DEFAULT *post.UploadPostMediaReq
+var CommentServiceUnlikeCommentArgs_Request_DEFAULT *comment.UnlikeCommentReq
 
-func (p *PostServiceUploadPostMediaArgs) GetRequest() (v *post.UploadPostMediaReq) {
+func (p *CommentServiceUnlikeCommentArgs) GetRequest() (v *comment.UnlikeCommentReq) {
 	if !p.IsSetRequest() {
-		return PostServiceUploadPostMediaArgs_Request_DEFAULT
+		return CommentServiceUnlikeCommentArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_PostServiceUploadPostMediaArgs = map[int16]string{
+var fieldIDToName_CommentServiceUnlikeCommentArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *PostServiceUploadPostMediaArgs) IsSetRequest() bool {
+func (p *CommentServiceUnlikeCommentArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *PostServiceUploadPostMediaArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceUnlikeCommentArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9356,7 +22635,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUploadPostMediaArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceUnlikeCommentArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9366,8 +22645,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceUploadPostMediaArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := post.NewUploadPostMediaReq()
+func (p *CommentServiceUnlikeCommentArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := comment.NewUnlikeCommentReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9375,9 +22654,9 @@ func (p *PostServiceUploadPostMediaArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *PostServiceUploadPostMediaArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceUnlikeCommentArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadPostMedia_args"); err != nil {
+	if err = oprot.WriteStructBegin("UnlikeComment_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9403,7 +22682,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceUploadPostMediaArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceUnlikeCommentArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9420,43 +22699,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *PostServiceUploadPostMediaArgs) String() string {
+func (p *CommentServiceUnlikeCommentArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceUploadPostMediaArgs(%+v)", *p)
+	return fmt.Sprintf("CommentServiceUnlikeCommentArgs(%+v)", *p)
 
 }
 
-type PostServiceUploadPostMediaResult struct {
-	Success *post.UploadPostMediaResp `thrift:"success,0,optional"`
+type CommentServiceUnlikeCommentResult struct {
+	Success *comment.UserFlagCommentResp `thrift:"success,0,optional"`
 }
 
-func NewPostServiceUploadPostMediaResult() *PostServiceUploadPostMediaResult {
-	return &PostServiceUploadPostMediaResult{}
+func NewCommentServiceUnlikeCommentResult() *CommentServiceUnlikeCommentResult {
+	return &CommentServiceUnlikeCommentResult{}
 }
 
-func (p *PostServiceUploadPostMediaResult) InitDefault() {
+func (p *CommentServiceUnlikeCommentResult) InitDefault() {
 }
 
-var PostServiceUploadPostMediaResult_Success_DEFAULT *post.UploadPostMediaResp
+var CommentServiceUnlikeCommentResult_Success_DEFAULT *comment.UserFlagCommentResp
 
-func (p *PostServiceUploadPostMediaResult) GetSuccess() (v *post.UploadPostMediaResp) {
+func (p *CommentServiceUnlikeCommentResult) GetSuccess() (v *comment.UserFlagCommentResp) {
 	if !p.IsSetSuccess() {
-		return PostServiceUploadPostMediaResult_Success_DEFAULT
+		return CommentServiceUnlikeCommentResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_PostServiceUploadPostMediaResult = map[int16]string{
+var fieldIDToName_CommentServiceUnlikeCommentResult = map[int16]string{
 	0: "success",
 }
 
-func (p *PostServiceUploadPostMediaResult) IsSetSuccess() bool {
+func (p *CommentServiceUnlikeCommentResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *PostServiceUploadPostMediaResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *CommentServiceUnlikeCommentResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9502,7 +22781,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_PostServiceUploadPostMediaResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CommentServiceUnlikeCommentResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9512,8 +22791,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *PostServiceUploadPostMediaResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := post.NewUploadPostMediaResp()
+func (p *CommentServiceUnlikeCommentResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := comment.NewUserFlagCommentResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9521,9 +22800,9 @@ func (p *PostServiceUploadPostMediaResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *PostServiceUploadPostMediaResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceUnlikeCommentResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("UploadPostMedia_result"); err != nil {
+	if err = oprot.WriteStructBegin("UnlikeComment_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9549,7 +22828,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *PostServiceUploadPostMediaResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *CommentServiceUnlikeCommentResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9568,38 +22847,39 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *PostServiceUploadPostMediaResult) String() string {
+func (p *CommentServiceUnlikeCommentResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PostServiceUploadPostMediaResult(%+v)", *p)
+	return fmt.Sprintf("CommentServiceUnlikeCommentResult(%+v)", *p)
 
 }
 
-type SchoolServiceProcessor struct {
+type TagServiceProcessor struct {
 	processorMap map[string]thrift.TProcessorFunction
-	handler      SchoolService
+	handler      TagService
 }
 
-func (p *SchoolServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+func (p *TagServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
 	p.processorMap[key] = processor
 }
 
-func (p *SchoolServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+func (p *TagServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
 	processor, ok = p.processorMap[key]
 	return processor, ok
 }
 
-func (p *SchoolServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+func (p *TagServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
 	return p.processorMap
 }
 
-func NewSchoolServiceProcessor(handler SchoolService) *SchoolServiceProcessor {
-	self := &SchoolServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetAllSchools", &schoolServiceProcessorGetAllSchools{handler: handler})
+func NewTagServiceProcessor(handler TagService) *TagServiceProcessor {
+	self := &TagServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
+	self.AddToProcessorMap("GetTagPosts", &tagServiceProcessorGetTagPosts{handler: handler})
+	self.AddToProcessorMap("GetTrendingTags", &tagServiceProcessorGetTrendingTags{handler: handler})
 	return self
 }
-func (p *SchoolServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+func (p *TagServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
 	name, _, seqId, err := iprot.ReadMessageBegin()
 	if err != nil {
 		return false, err
@@ -9617,16 +22897,16 @@ func (p *SchoolServiceProcessor) Process(ctx context.Context, iprot, oprot thrif
 	return false, x
 }
 
-type schoolServiceProcessorGetAllSchools struct {
-	handler SchoolService
+type tagServiceProcessorGetTagPosts struct {
+	handler TagService
 }
 
-func (p *schoolServiceProcessorGetAllSchools) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := SchoolServiceGetAllSchoolsArgs{}
+func (p *tagServiceProcessorGetTagPosts) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TagServiceGetTagPostsArgs{}
 	if err = args.Read(iprot); err != nil {
 		iprot.ReadMessageEnd()
 		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetAllSchools", thrift.EXCEPTION, seqId)
+		oprot.WriteMessageBegin("GetTagPosts", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -9635,11 +22915,11 @@ func (p *schoolServiceProcessorGetAllSchools) Process(ctx context.Context, seqId
 
 	iprot.ReadMessageEnd()
 	var err2 error
-	result := SchoolServiceGetAllSchoolsResult{}
-	var retval *school.GetAllSchoolsResp
-	if retval, err2 = p.handler.GetAllSchools(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllSchools: "+err2.Error())
-		oprot.WriteMessageBegin("GetAllSchools", thrift.EXCEPTION, seqId)
+	result := TagServiceGetTagPostsResult{}
+	var retval *tag.GetTagPostsResp
+	if retval, err2 = p.handler.GetTagPosts(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTagPosts: "+err2.Error())
+		oprot.WriteMessageBegin("GetTagPosts", thrift.EXCEPTION, seqId)
 		x.Write(oprot)
 		oprot.WriteMessageEnd()
 		oprot.Flush(ctx)
@@ -9647,7 +22927,7 @@ func (p *schoolServiceProcessorGetAllSchools) Process(ctx context.Context, seqId
 	} else {
 		result.Success = retval
 	}
-	if err2 = oprot.WriteMessageBegin("GetAllSchools", thrift.REPLY, seqId); err2 != nil {
+	if err2 = oprot.WriteMessageBegin("GetTagPosts", thrift.REPLY, seqId); err2 != nil {
 		err = err2
 	}
 	if err2 = result.Write(oprot); err == nil && err2 != nil {
@@ -9665,35 +22945,83 @@ func (p *schoolServiceProcessorGetAllSchools) Process(ctx context.Context, seqId
 	return true, err
 }
 
-type SchoolServiceGetAllSchoolsArgs struct {
-	Request *school.GetAllSchoolsReq `thrift:"request,1"`
+type tagServiceProcessorGetTrendingTags struct {
+	handler TagService
 }
 
-func NewSchoolServiceGetAllSchoolsArgs() *SchoolServiceGetAllSchoolsArgs {
-	return &SchoolServiceGetAllSchoolsArgs{}
+func (p *tagServiceProcessorGetTrendingTags) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+	args := TagServiceGetTrendingTagsArgs{}
+	if err = args.Read(iprot); err != nil {
+		iprot.ReadMessageEnd()
+		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+		oprot.WriteMessageBegin("GetTrendingTags", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return false, err
+	}
+
+	iprot.ReadMessageEnd()
+	var err2 error
+	result := TagServiceGetTrendingTagsResult{}
+	var retval *tag.GetTrendingTagsResp
+	if retval, err2 = p.handler.GetTrendingTags(ctx, args.Request); err2 != nil {
+		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTrendingTags: "+err2.Error())
+		oprot.WriteMessageBegin("GetTrendingTags", thrift.EXCEPTION, seqId)
+		x.Write(oprot)
+		oprot.WriteMessageEnd()
+		oprot.Flush(ctx)
+		return true, err2
+	} else {
+		result.Success = retval
+	}
+	if err2 = oprot.WriteMessageBegin("GetTrendingTags", thrift.REPLY, seqId); err2 != nil {
+		err = err2
+	}
+	if err2 = result.Write(oprot); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+		err = err2
+	}
+	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+		err = err2
+	}
+	if err != nil {
+		return
+	}
+	return true, err
 }
 
-func (p *SchoolServiceGetAllSchoolsArgs) InitDefault() {
+type TagServiceGetTagPostsArgs struct {
+	Request *tag.GetTagPostsReq `thrift:"request,1"`
 }
 
-var SchoolServiceGetAllSchoolsArgs_Request_DEFAULT *school.GetAllSchoolsReq
+func NewTagServiceGetTagPostsArgs() *TagServiceGetTagPostsArgs {
+	return &TagServiceGetTagPostsArgs{}
+}
 
-func (p *SchoolServiceGetAllSchoolsArgs) GetRequest() (v *school.GetAllSchoolsReq) {
+func (p *TagServiceGetTagPostsArgs) InitDefault() {
+}
+
+var TagServiceGetTagPostsArgs_Request_DEFAULT *tag.GetTagPostsReq
+
+func (p *TagServiceGetTagPostsArgs) GetRequest() (v *tag.GetTagPostsReq) {
 	if !p.IsSetRequest() {
-		return SchoolServiceGetAllSchoolsArgs_Request_DEFAULT
+		return TagServiceGetTagPostsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_SchoolServiceGetAllSchoolsArgs = map[int16]string{
+var fieldIDToName_TagServiceGetTagPostsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *SchoolServiceGetAllSchoolsArgs) IsSetRequest() bool {
+func (p *TagServiceGetTagPostsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *SchoolServiceGetAllSchoolsArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTagPostsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9739,7 +23067,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SchoolServiceGetAllSchoolsArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TagServiceGetTagPostsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9749,8 +23077,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SchoolServiceGetAllSchoolsArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := school.NewGetAllSchoolsReq()
+func (p *TagServiceGetTagPostsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := tag.NewGetTagPostsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9758,9 +23086,9 @@ func (p *SchoolServiceGetAllSchoolsArgs) ReadField1(iprot thrift.TProtocol) erro
 	return nil
 }
 
-func (p *SchoolServiceGetAllSchoolsArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTagPostsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllSchools_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTagPosts_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9786,7 +23114,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SchoolServiceGetAllSchoolsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTagPostsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -9803,43 +23131,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *SchoolServiceGetAllSchoolsArgs) String() string {
+func (p *TagServiceGetTagPostsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SchoolServiceGetAllSchoolsArgs(%+v)", *p)
+	return fmt.Sprintf("TagServiceGetTagPostsArgs(%+v)", *p)
 
 }
 
-type SchoolServiceGetAllSchoolsResult struct {
-	Success *school.GetAllSchoolsResp `thrift:"success,0,optional"`
+type TagServiceGetTagPostsResult struct {
+	Success *tag.GetTagPostsResp `thrift:"success,0,optional"`
 }
 
-func NewSchoolServiceGetAllSchoolsResult() *SchoolServiceGetAllSchoolsResult {
-	return &SchoolServiceGetAllSchoolsResult{}
+func NewTagServiceGetTagPostsResult() *TagServiceGetTagPostsResult {
+	return &TagServiceGetTagPostsResult{}
 }
 
-func (p *SchoolServiceGetAllSchoolsResult) InitDefault() {
+func (p *TagServiceGetTagPostsResult) InitDefault() {
 }
 
-var SchoolServiceGetAllSchoolsResult_Success_DEFAULT *school.GetAllSchoolsResp
+var TagServiceGetTagPostsResult_Success_DEFAULT *tag.GetTagPostsResp
 
-func (p *SchoolServiceGetAllSchoolsResult) GetSuccess() (v *school.GetAllSchoolsResp) {
+func (p *TagServiceGetTagPostsResult) GetSuccess() (v *tag.GetTagPostsResp) {
 	if !p.IsSetSuccess() {
-		return SchoolServiceGetAllSchoolsResult_Success_DEFAULT
+		return TagServiceGetTagPostsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_SchoolServiceGetAllSchoolsResult = map[int16]string{
+var fieldIDToName_TagServiceGetTagPostsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *SchoolServiceGetAllSchoolsResult) IsSetSuccess() bool {
+func (p *TagServiceGetTagPostsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *SchoolServiceGetAllSchoolsResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTagPostsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -9885,7 +23213,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_SchoolServiceGetAllSchoolsResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TagServiceGetTagPostsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -9895,8 +23223,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *SchoolServiceGetAllSchoolsResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := school.NewGetAllSchoolsResp()
+func (p *TagServiceGetTagPostsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := tag.NewGetTagPostsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -9904,9 +23232,9 @@ func (p *SchoolServiceGetAllSchoolsResult) ReadField0(iprot thrift.TProtocol) er
 	return nil
 }
 
-func (p *SchoolServiceGetAllSchoolsResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTagPostsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllSchools_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTagPosts_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -9932,7 +23260,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *SchoolServiceGetAllSchoolsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTagPostsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -9951,132 +23279,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *SchoolServiceGetAllSchoolsResult) String() string {
+func (p *TagServiceGetTagPostsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SchoolServiceGetAllSchoolsResult(%+v)", *p)
-
-}
-
-type CategoryServiceProcessor struct {
-	processorMap map[string]thrift.TProcessorFunction
-	handler      CategoryService
-}
-
-func (p *CategoryServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-	p.processorMap[key] = processor
-}
-
-func (p *CategoryServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-	processor, ok = p.processorMap[key]
-	return processor, ok
-}
-
-func (p *CategoryServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-	return p.processorMap
-}
-
-func NewCategoryServiceProcessor(handler CategoryService) *CategoryServiceProcessor {
-	self := &CategoryServiceProcessor{handler: handler, processorMap: make(map[string]thrift.TProcessorFunction)}
-	self.AddToProcessorMap("GetAllCategories", &categoryServiceProcessorGetAllCategories{handler: handler})
-	return self
-}
-func (p *CategoryServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	name, _, seqId, err := iprot.ReadMessageBegin()
-	if err != nil {
-		return false, err
-	}
-	if processor, ok := p.GetProcessorFunction(name); ok {
-		return processor.Process(ctx, seqId, iprot, oprot)
-	}
-	iprot.Skip(thrift.STRUCT)
-	iprot.ReadMessageEnd()
-	x := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function "+name)
-	oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-	x.Write(oprot)
-	oprot.WriteMessageEnd()
-	oprot.Flush(ctx)
-	return false, x
-}
-
-type categoryServiceProcessorGetAllCategories struct {
-	handler CategoryService
-}
+	return fmt.Sprintf("TagServiceGetTagPostsResult(%+v)", *p)
 
-func (p *categoryServiceProcessorGetAllCategories) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-	args := CategoryServiceGetAllCategoriesArgs{}
-	if err = args.Read(iprot); err != nil {
-		iprot.ReadMessageEnd()
-		x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-		oprot.WriteMessageBegin("GetAllCategories", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return false, err
-	}
-
-	iprot.ReadMessageEnd()
-	var err2 error
-	result := CategoryServiceGetAllCategoriesResult{}
-	var retval *category.GetAllCategoriesResp
-	if retval, err2 = p.handler.GetAllCategories(ctx, args.Request); err2 != nil {
-		x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetAllCategories: "+err2.Error())
-		oprot.WriteMessageBegin("GetAllCategories", thrift.EXCEPTION, seqId)
-		x.Write(oprot)
-		oprot.WriteMessageEnd()
-		oprot.Flush(ctx)
-		return true, err2
-	} else {
-		result.Success = retval
-	}
-	if err2 = oprot.WriteMessageBegin("GetAllCategories", thrift.REPLY, seqId); err2 != nil {
-		err = err2
-	}
-	if err2 = result.Write(oprot); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-		err = err2
-	}
-	if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-		err = err2
-	}
-	if err != nil {
-		return
-	}
-	return true, err
 }
 
-type CategoryServiceGetAllCategoriesArgs struct {
-	Request *category.GetAllCategoriesReq `thrift:"request,1"`
+type TagServiceGetTrendingTagsArgs struct {
+	Request *tag.GetTrendingTagsReq `thrift:"request,1"`
 }
 
-func NewCategoryServiceGetAllCategoriesArgs() *CategoryServiceGetAllCategoriesArgs {
-	return &CategoryServiceGetAllCategoriesArgs{}
+func NewTagServiceGetTrendingTagsArgs() *TagServiceGetTrendingTagsArgs {
+	return &TagServiceGetTrendingTagsArgs{}
 }
 
-func (p *CategoryServiceGetAllCategoriesArgs) InitDefault() {
+func (p *TagServiceGetTrendingTagsArgs) InitDefault() {
 }
 
-var CategoryServiceGetAllCategoriesArgs_Request_DEFAULT *category.GetAllCategoriesReq
+var TagServiceGetTrendingTagsArgs_Request_DEFAULT *tag.GetTrendingTagsReq
 
-func (p *CategoryServiceGetAllCategoriesArgs) GetRequest() (v *category.GetAllCategoriesReq) {
+func (p *TagServiceGetTrendingTagsArgs) GetRequest() (v *tag.GetTrendingTagsReq) {
 	if !p.IsSetRequest() {
-		return CategoryServiceGetAllCategoriesArgs_Request_DEFAULT
+		return TagServiceGetTrendingTagsArgs_Request_DEFAULT
 	}
 	return p.Request
 }
 
-var fieldIDToName_CategoryServiceGetAllCategoriesArgs = map[int16]string{
+var fieldIDToName_TagServiceGetTrendingTagsArgs = map[int16]string{
 	1: "request",
 }
 
-func (p *CategoryServiceGetAllCategoriesArgs) IsSetRequest() bool {
+func (p *TagServiceGetTrendingTagsArgs) IsSetRequest() bool {
 	return p.Request != nil
 }
 
-func (p *CategoryServiceGetAllCategoriesArgs) Read(iprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTrendingTagsArgs) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10122,7 +23361,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CategoryServiceGetAllCategoriesArgs[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TagServiceGetTrendingTagsArgs[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10132,8 +23371,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CategoryServiceGetAllCategoriesArgs) ReadField1(iprot thrift.TProtocol) error {
-	_field := category.NewGetAllCategoriesReq()
+func (p *TagServiceGetTrendingTagsArgs) ReadField1(iprot thrift.TProtocol) error {
+	_field := tag.NewGetTrendingTagsReq()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10141,9 +23380,9 @@ func (p *CategoryServiceGetAllCategoriesArgs) ReadField1(iprot thrift.TProtocol)
 	return nil
 }
 
-func (p *CategoryServiceGetAllCategoriesArgs) Write(oprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTrendingTagsArgs) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllCategories_args"); err != nil {
+	if err = oprot.WriteStructBegin("GetTrendingTags_args"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10169,7 +23408,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CategoryServiceGetAllCategoriesArgs) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTrendingTagsArgs) writeField1(oprot thrift.TProtocol) (err error) {
 	if err = oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
 		goto WriteFieldBeginError
 	}
@@ -10186,43 +23425,43 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 1 end error: ", p), err)
 }
 
-func (p *CategoryServiceGetAllCategoriesArgs) String() string {
+func (p *TagServiceGetTrendingTagsArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CategoryServiceGetAllCategoriesArgs(%+v)", *p)
+	return fmt.Sprintf("TagServiceGetTrendingTagsArgs(%+v)", *p)
 
 }
 
-type CategoryServiceGetAllCategoriesResult struct {
-	Success *category.GetAllCategoriesResp `thrift:"success,0,optional"`
+type TagServiceGetTrendingTagsResult struct {
+	Success *tag.GetTrendingTagsResp `thrift:"success,0,optional"`
 }
 
-func NewCategoryServiceGetAllCategoriesResult() *CategoryServiceGetAllCategoriesResult {
-	return &CategoryServiceGetAllCategoriesResult{}
+func NewTagServiceGetTrendingTagsResult() *TagServiceGetTrendingTagsResult {
+	return &TagServiceGetTrendingTagsResult{}
 }
 
-func (p *CategoryServiceGetAllCategoriesResult) InitDefault() {
+func (p *TagServiceGetTrendingTagsResult) InitDefault() {
 }
 
-var CategoryServiceGetAllCategoriesResult_Success_DEFAULT *category.GetAllCategoriesResp
+var TagServiceGetTrendingTagsResult_Success_DEFAULT *tag.GetTrendingTagsResp
 
-func (p *CategoryServiceGetAllCategoriesResult) GetSuccess() (v *category.GetAllCategoriesResp) {
+func (p *TagServiceGetTrendingTagsResult) GetSuccess() (v *tag.GetTrendingTagsResp) {
 	if !p.IsSetSuccess() {
-		return CategoryServiceGetAllCategoriesResult_Success_DEFAULT
+		return TagServiceGetTrendingTagsResult_Success_DEFAULT
 	}
 	return p.Success
 }
 
-var fieldIDToName_CategoryServiceGetAllCategoriesResult = map[int16]string{
+var fieldIDToName_TagServiceGetTrendingTagsResult = map[int16]string{
 	0: "success",
 }
 
-func (p *CategoryServiceGetAllCategoriesResult) IsSetSuccess() bool {
+func (p *TagServiceGetTrendingTagsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *CategoryServiceGetAllCategoriesResult) Read(iprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTrendingTagsResult) Read(iprot thrift.TProtocol) (err error) {
 
 	var fieldTypeId thrift.TType
 	var fieldId int16
@@ -10268,7 +23507,7 @@ ReadStructBeginError:
 ReadFieldBeginError:
 	return thrift.PrependError(fmt.Sprintf("%T read field %d begin error: ", p, fieldId), err)
 ReadFieldError:
-	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_CategoryServiceGetAllCategoriesResult[fieldId]), err)
+	return thrift.PrependError(fmt.Sprintf("%T read field %d '%s' error: ", p, fieldId, fieldIDToName_TagServiceGetTrendingTagsResult[fieldId]), err)
 SkipFieldError:
 	return thrift.PrependError(fmt.Sprintf("%T field %d skip type %d error: ", p, fieldId, fieldTypeId), err)
 
@@ -10278,8 +23517,8 @@ ReadStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 }
 
-func (p *CategoryServiceGetAllCategoriesResult) ReadField0(iprot thrift.TProtocol) error {
-	_field := category.NewGetAllCategoriesResp()
+func (p *TagServiceGetTrendingTagsResult) ReadField0(iprot thrift.TProtocol) error {
+	_field := tag.NewGetTrendingTagsResp()
 	if err := _field.Read(iprot); err != nil {
 		return err
 	}
@@ -10287,9 +23526,9 @@ func (p *CategoryServiceGetAllCategoriesResult) ReadField0(iprot thrift.TProtoco
 	return nil
 }
 
-func (p *CategoryServiceGetAllCategoriesResult) Write(oprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTrendingTagsResult) Write(oprot thrift.TProtocol) (err error) {
 	var fieldId int16
-	if err = oprot.WriteStructBegin("GetAllCategories_result"); err != nil {
+	if err = oprot.WriteStructBegin("GetTrendingTags_result"); err != nil {
 		goto WriteStructBeginError
 	}
 	if p != nil {
@@ -10315,7 +23554,7 @@ WriteStructEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write struct end error: ", p), err)
 }
 
-func (p *CategoryServiceGetAllCategoriesResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *TagServiceGetTrendingTagsResult) writeField0(oprot thrift.TProtocol) (err error) {
 	if p.IsSetSuccess() {
 		if err = oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
 			goto WriteFieldBeginError
@@ -10334,10 +23573,10 @@ WriteFieldEndError:
 	return thrift.PrependError(fmt.Sprintf("%T write field 0 end error: ", p), err)
 }
 
-func (p *CategoryServiceGetAllCategoriesResult) String() string {
+func (p *TagServiceGetTrendingTagsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("CategoryServiceGetAllCategoriesResult(%+v)", *p)
+	return fmt.Sprintf("TagServiceGetTrendingTagsResult(%+v)", *p)
 
 }
@@ -0,0 +1,290 @@
+// Package contentfilter checks user text (post title / content) before it is saved.
+//
+// A Pipeline runs ContentFilters in order. Every filter looks at the text and decides:
+//   - Allow:  nothing to say;
+//   - Mask:   the text is fine once some parts are replaced (e.g. banned words => "***");
+//     later filters see the masked text;
+//   - Review: the text is saved but held back until a moderator looks at it;
+//   - Reject: the text is refused, the pipeline stops right away.
+//
+// The pipeline result is the strictest action of all filters, with every reason collected.
+package contentfilter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Action is what a filter wants done with the text. Larger = stricter.
+type Action int
+
+const (
+	Allow Action = iota
+	Mask
+	Review
+	Reject
+)
+
+func (a Action) String() string {
+	switch a {
+	case Allow:
+		return "allow"
+	case Mask:
+		return "mask"
+	case Review:
+		return "review"
+	case Reject:
+		return "reject"
+	}
+	return fmt.Sprintf("Action(%d)", int(a))
+}
+
+// ParseAction parses "mask" / "review" / "reject" (config values). "" and unknown values give def.
+func ParseAction(s string, def Action) Action {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "mask":
+		return Mask
+	case "review":
+		return Review
+	case "reject":
+		return Reject
+	}
+	return def
+}
+
+// Input is the text to check. SchoolID lets filters apply per-school rules.
+type Input struct {
+	SchoolID int64
+	Title    string
+	Content  string
+}
+
+// Result is the decision of one filter.
+//   - Reason is required for everything but Allow.
+//   - Title / Content are the masked text, only read when Action == Mask.
+type Result struct {
+	Action  Action
+	Reason  string
+	Title   string
+	Content string
+}
+
+// ContentFilter is one check of the pipeline.
+type ContentFilter interface {
+	Name() string
+	Check(in Input) Result
+}
+
+// Verdict is the outcome of a pipeline run.
+type Verdict struct {
+	Action  Action
+	Reasons []string // "filter: reason", in filter order
+	Title   string   // text to save (masked if any filter masked)
+	Content string
+}
+
+// Pipeline is an ordered list of filters.
+type Pipeline []ContentFilter
+
+// Run checks in with every filter, see the package comment.
+func (p Pipeline) Run(in Input) Verdict {
+	v := Verdict{Action: Allow, Title: in.Title, Content: in.Content}
+	for _, f := range p {
+		r := f.Check(Input{SchoolID: in.SchoolID, Title: v.Title, Content: v.Content})
+		if r.Action == Allow {
+			continue
+		}
+		v.Reasons = append(v.Reasons, f.Name()+": "+r.Reason)
+		if r.Action > v.Action {
+			v.Action = r.Action
+		}
+		switch r.Action {
+		case Mask:
+			v.Title, v.Content = r.Title, r.Content
+		case Reject:
+			return v
+		}
+	}
+	return v
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Built-in filters
+///////////////////////////////////////////////////////////////////////////////
+
+// LengthFilter rejects titles / contents longer than the limits (in characters, <= 0 = no limit).
+type LengthFilter struct {
+	MaxTitle   int
+	MaxContent int
+}
+
+func (LengthFilter) Name() string { return "length" }
+
+func (f LengthFilter) Check(in Input) Result {
+	if f.MaxTitle > 0 && utf8.RuneCountInString(in.Title) > f.MaxTitle {
+		return Result{Action: Reject, Reason: fmt.Sprintf("title must be at most %d characters", f.MaxTitle)}
+	}
+	if f.MaxContent > 0 && utf8.RuneCountInString(in.Content) > f.MaxContent {
+		return Result{Action: Reject, Reason: fmt.Sprintf("content must be at most %d characters", f.MaxContent)}
+	}
+	return Result{}
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// CountLinks returns how many http(s):// or www. links s contains.
+func CountLinks(s string) int {
+	return len(linkPattern.FindAllStringIndex(s, -1))
+}
+
+// LinkFilter limits the number of links in title + content.
+type LinkFilter struct {
+	MaxLinks int
+	Action   Action // what to do above the limit, Reject if zero
+}
+
+func (LinkFilter) Name() string { return "links" }
+
+func (f LinkFilter) Check(in Input) Result {
+	n := CountLinks(in.Title) + CountLinks(in.Content)
+	if n <= f.MaxLinks {
+		return Result{}
+	}
+	action := f.Action
+	if action == Allow {
+		action = Reject
+	}
+	return Result{Action: action, Reason: fmt.Sprintf("at most %d links are allowed, got %d", f.MaxLinks, n)}
+}
+
+// LongestRun returns the length of the longest run of one repeated non-space character in s
+// (whitespace breaks a run, so indentation is not spam).
+func LongestRun(s string) int {
+	longest, run := 0, 0
+	var prev rune = -1
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			prev, run = -1, 0
+			continue
+		}
+		if r == prev {
+			run++
+		} else {
+			prev, run = r, 1
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
+
+// RepeatFilter catches "!!!!!!!!!!!!!!!!" / "哈哈哈哈哈哈哈哈哈哈哈哈哈哈哈哈哈哈" style spam:
+// one character repeated more than MaxRun times in a row.
+type RepeatFilter struct {
+	MaxRun int
+	Action Action // Reject if zero
+}
+
+func (RepeatFilter) Name() string { return "repeat" }
+
+func (f RepeatFilter) Check(in Input) Result {
+	if f.MaxRun <= 0 {
+		return Result{}
+	}
+	if LongestRun(in.Title) <= f.MaxRun && LongestRun(in.Content) <= f.MaxRun {
+		return Result{}
+	}
+	action := f.Action
+	if action == Allow {
+		action = Reject
+	}
+	return Result{Action: action, Reason: fmt.Sprintf("a character is repeated more than %d times", f.MaxRun)}
+}
+
+// WordRule is a list of banned words and what to do when one is found.
+type WordRule struct {
+	Words  []string
+	Action Action // Mask if zero
+}
+
+// BannedWordFilter looks for banned words (case-insensitive substring match).
+// Global applies to every school, Schools[schoolID] is added on top for that school;
+// when both match, the stricter action wins.
+type BannedWordFilter struct {
+	Global  WordRule
+	Schools map[int64]WordRule
+}
+
+func (BannedWordFilter) Name() string { return "banned_words" }
+
+func (f BannedWordFilter) Check(in Input) Result {
+	rules := []WordRule{f.Global}
+	if r, ok := f.Schools[in.SchoolID]; ok {
+		rules = append(rules, r)
+	}
+
+	title, content := []rune(in.Title), []rune(in.Content)
+	action := Allow
+	var found []string
+	for _, rule := range rules {
+		hit := false
+		for _, w := range rule.Words {
+			word := []rune(strings.TrimSpace(w))
+			if len(word) == 0 {
+				continue
+			}
+			t, c := maskWord(title, word), maskWord(content, word)
+			if t || c {
+				hit = true
+				found = append(found, string(word))
+			}
+		}
+		if !hit {
+			continue
+		}
+		a := rule.Action
+		if a == Allow {
+			a = Mask
+		}
+		if a > action {
+			action = a
+		}
+	}
+	if action == Allow {
+		return Result{}
+	}
+
+	res := Result{Action: action, Reason: "contains banned words"}
+	if action == Mask {
+		res.Title, res.Content = string(title), string(content)
+		res.Reason = fmt.Sprintf("%d banned words masked", len(found))
+	}
+	return res
+}
+
+// maskWord replaces every case-insensitive occurrence of word in text with '*' (in place).
+func maskWord(text, word []rune) bool {
+	found := false
+	for i := 0; i+len(word) <= len(text); i++ {
+		match := true
+		for j, r := range word {
+			if unicode.ToLower(text[i+j]) != unicode.ToLower(r) {
+				match = false
+				break
+			}
+		}
+		if !match {
+			continue
+		}
+		found = true
+		for j := range word {
+			text[i+j] = '*'
+		}
+		i += len(word) - 1
+	}
+	return found
+}
@@ -0,0 +1,58 @@
+package contentfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBannedWordFilter(t *testing.T) {
+	f := BannedWordFilter{
+		Global:  WordRule{Words: []string{"darn"}},
+		Schools: map[int64]WordRule{7: {Words: []string{"beer"}, Action: Review}},
+	}
+
+	r := f.Check(Input{SchoolID: 1, Title: "Darn it", Content: "darn, 该死的 darnit"})
+	assert.Equal(t, Mask, r.Action)
+	assert.Equal(t, "**** it", r.Title)
+	assert.Equal(t, "****, 该死的 ****it", r.Content)
+
+	// per-school words only apply to that school, the stricter action wins
+	assert.Equal(t, Allow, f.Check(Input{SchoolID: 1, Content: "free beer"}).Action)
+	assert.Equal(t, Review, f.Check(Input{SchoolID: 7, Content: "darn, free BEER"}).Action)
+}
+
+func TestLinkAndRepeatFilters(t *testing.T) {
+	assert.Equal(t, 2, CountLinks("see https://a.com and www.b.org/x, not a.com"))
+	assert.Equal(t, 4, LongestRun("哈哈哈哈 !!! aa"))
+	assert.Equal(t, 1, LongestRun("a a a"))
+
+	links := LinkFilter{MaxLinks: 1}
+	assert.Equal(t, Allow, links.Check(Input{Content: "http://a.com"}).Action)
+	assert.Equal(t, Reject, links.Check(Input{Title: "http://a.com", Content: "http://b.com"}).Action)
+
+	repeat := RepeatFilter{MaxRun: 3, Action: Review}
+	assert.Equal(t, Allow, repeat.Check(Input{Content: "好好好"}).Action)
+	assert.Equal(t, Review, repeat.Check(Input{Content: "好好好好"}).Action)
+}
+
+func TestPipeline(t *testing.T) {
+	p := Pipeline{
+		BannedWordFilter{Global: WordRule{Words: []string{"spam"}}},
+		LengthFilter{MaxTitle: 10, MaxContent: 20},
+		RepeatFilter{MaxRun: 5, Action: Review},
+	}
+
+	v := p.Run(Input{Title: "hi", Content: "no spam here"})
+	assert.Equal(t, Mask, v.Action)
+	assert.Equal(t, "no **** here", v.Content)
+	assert.Len(t, v.Reasons, 1)
+
+	v = p.Run(Input{Title: "hi", Content: "spam!!!!!!"})
+	assert.Equal(t, Review, v.Action)
+	assert.Len(t, v.Reasons, 2)
+
+	v = p.Run(Input{Title: "a very long title", Content: "!!!!!!!!"})
+	assert.Equal(t, Reject, v.Action)
+	assert.Equal(t, []string{"length: title must be at most 10 characters"}, v.Reasons)
+}
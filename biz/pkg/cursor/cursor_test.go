@@ -0,0 +1,48 @@
+package cursor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testSecret = []byte("test-secret")
+
+func TestEncodeDecode(t *testing.T) {
+	token := Encode(testSecret, "time", 1700000000123456789, 42)
+
+	values, err := Decode(testSecret, "time", token, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1700000000123456789, 42}, values)
+}
+
+func TestDecodeRejectsTampered(t *testing.T) {
+	token := Encode(testSecret, "time", 100, 1)
+	forged := Encode([]byte("other-secret"), "time", 100, 1)
+
+	_, err := Decode(testSecret, "time", forged, 2)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	// swap payload, keep signature
+	payload, sig, _ := strings.Cut(token, ".")
+	otherPayload, _, _ := strings.Cut(Encode(testSecret, "time", 999, 1), ".")
+	assert.NotEqual(t, payload, otherPayload)
+	_, err = Decode(testSecret, "time", otherPayload+"."+sig, 2)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestDecodeRejectsWrongKindOrShape(t *testing.T) {
+	token := Encode(testSecret, "hot", 10, 3)
+
+	_, err := Decode(testSecret, "time", token, 2)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = Decode(testSecret, "hot", token, 1)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+
+	for _, bad := range []string{"", "abc", "a.b.c", "!!!.###"} {
+		_, err = Decode(testSecret, "hot", bad, 2)
+		assert.ErrorIs(t, err, ErrInvalidCursor, bad)
+	}
+}
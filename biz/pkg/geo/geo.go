@@ -0,0 +1,70 @@
+// Package geo has the small amount of geometry the nearby feed needs.
+//
+// Coordinates are WGS84 degrees. Distances are meters on a sphere (haversine),
+// which is accurate enough for "posts around me" within tens of kilometers.
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	EarthRadiusM = 6371000.0
+
+	// CoordPrecision is the number of decimals kept when a coordinate is stored,
+	// 3 decimals ≈ 110m of latitude, so the exact spot of the author is never saved.
+	CoordPrecision = 3
+
+	metersPerDegreeLat = math.Pi * EarthRadiusM / 180
+)
+
+var ErrInvalidCoord = errors.New("latitude must be in [-90, 90] and longitude in [-180, 180]")
+
+// Validate checks lat / lng ranges.
+func Validate(lat, lng float64) error {
+	if math.IsNaN(lat) || math.IsNaN(lng) || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return ErrInvalidCoord
+	}
+	return nil
+}
+
+// Round rounds a coordinate to CoordPrecision decimals.
+func Round(v float64) float64 {
+	p := math.Pow(10, CoordPrecision)
+	return math.Round(v*p) / p
+}
+
+// Box is a lat / lng bounding box.
+type Box struct {
+	MinLat, MinLng, MaxLat, MaxLng float64
+}
+
+// BoundingBox returns a box containing every point within radiusM meters of (lat, lng).
+// It is clamped to valid ranges and does not wrap around the antimeridian.
+func BoundingBox(lat, lng, radiusM float64) Box {
+	dLat := radiusM / metersPerDegreeLat
+
+	// widest longitude offset of the circle, asin(sin(r/R) / cos(lat))
+	dLng := 180.0
+	if s := math.Sin(radiusM/EarthRadiusM) / math.Cos(lat*math.Pi/180); s >= 0 && s < 1 {
+		dLng = math.Asin(s) * 180 / math.Pi
+	}
+
+	return Box{
+		MinLat: math.Max(-90, lat-dLat),
+		MaxLat: math.Min(90, lat+dLat),
+		MinLng: math.Max(-180, lng-dLng),
+		MaxLng: math.Min(180, lng+dLng),
+	}
+}
+
+// Distance returns the haversine distance in meters.
+func Distance(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := math.Pi / 180
+	dLat := (lat2 - lat1) * toRad
+	dLng := (lng2 - lng1) * toRad
+	a := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(lat1*toRad)*math.Cos(lat2*toRad)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusM * math.Asin(math.Min(1, math.Sqrt(a)))
+}
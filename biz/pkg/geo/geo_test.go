@@ -0,0 +1,39 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRound(t *testing.T) {
+	assert.Equal(t, 40.808, Round(40.80751))
+	assert.Equal(t, -73.962, Round(-73.96249))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(0, 0))
+	assert.NoError(t, Validate(-90, 180))
+	assert.ErrorIs(t, Validate(91, 0), ErrInvalidCoord)
+	assert.ErrorIs(t, Validate(0, -180.5), ErrInvalidCoord)
+}
+
+func TestBoundingBoxContainsRadius(t *testing.T) {
+	lat, lng, r := 40.8075, -73.9626, 2000.0
+	box := BoundingBox(lat, lng, r)
+
+	// the box edges are at least r away, the corners are farther
+	assert.InDelta(t, r, Distance(lat, lng, box.MaxLat, lng), 1)
+	assert.GreaterOrEqual(t, Distance(lat, lng, lat, box.MaxLng), r)
+	assert.Greater(t, Distance(lat, lng, box.MinLat, box.MinLng), r)
+
+	polar := BoundingBox(89.9999, 0, 50000)
+	assert.Equal(t, 90.0, polar.MaxLat)
+	assert.Equal(t, -180.0, polar.MinLng)
+}
+
+func TestDistance(t *testing.T) {
+	// one degree of latitude ≈ 111.2km
+	assert.InDelta(t, 111195, Distance(0, 0, 1, 0), 10)
+	assert.Equal(t, 0.0, Distance(10, 20, 10, 20))
+}
@@ -0,0 +1,121 @@
+// Package ratelimit is a token bucket rate limiter.
+//
+// Every key (e.g. "/post/create|user:42") has a bucket of Burst tokens that refills at
+// Rate tokens per second; a request takes one token or is refused with the time until
+// the next token is available.
+//
+// Buckets live in a Store. MemoryStore keeps them in this process, which is enough for a
+// single server; a shared store (e.g. Redis) can implement Store when there are several.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is the rule of one bucket.
+type Limit struct {
+	Rate  float64 // tokens per second
+	Burst int     // bucket size, max requests at once
+}
+
+// PerPeriod is a Limit of n requests per period, with burst of up to burst requests at once
+// (burst <= 0 => n).
+func PerPeriod(n int, period time.Duration, burst int) Limit {
+	if burst <= 0 {
+		burst = n
+	}
+	return Limit{Rate: float64(n) / period.Seconds(), Burst: burst}
+}
+
+// Valid reports whether l limits anything (a zero Limit means "no limit").
+func (l Limit) Valid() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// Store takes tokens from buckets.
+type Store interface {
+	// Take takes one token of key's bucket at time now.
+	// If the bucket is empty it returns false and how long until a token is available.
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (allowed bool, retryAfter time.Duration, err error)
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// take refills b up to now and takes one token.
+func (b *bucket) take(limit Limit, now time.Time) (bool, time.Duration) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := (1 - b.tokens) / limit.Rate
+	return false, time.Duration(math.Ceil(wait * float64(time.Second)))
+}
+
+// full reports whether b would be full at now (then it can be dropped: a new bucket is the same).
+func (b *bucket) full(limit Limit, now time.Time) bool {
+	return b.tokens+now.Sub(b.last).Seconds()*limit.Rate >= float64(limit.Burst)
+}
+
+// MemoryStore is a Store in process memory. Safe for concurrent use.
+// Full buckets are dropped from time to time, so memory follows the number of active keys.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	limits    map[string]Limit // limit last used with each key, for the sweep
+	lastSweep time.Time
+}
+
+const sweepInterval = time.Minute
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		limits:  make(map[string]Limit),
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit, now time.Time) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		s.buckets[key] = b
+	}
+	s.limits[key] = limit
+	allowed, retryAfter := b.take(limit, now)
+	return allowed, retryAfter, nil
+}
+
+// sweep drops full buckets. Caller holds s.mu.
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		if b.full(s.limits[key], now) {
+			delete(s.buckets, key)
+			delete(s.limits, key)
+		}
+	}
+	s.lastSweep = now
+}
+
+// Len returns the number of buckets kept (for tests / metrics).
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.buckets)
+}
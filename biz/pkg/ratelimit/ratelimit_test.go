@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStoreTake(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	limit := PerPeriod(2, time.Minute, 3) // one token every 30s, 3 at once
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		ok, _, err := s.Take(ctx, "u1", limit, now)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	}
+	ok, retry, _ := s.Take(ctx, "u1", limit, now)
+	assert.False(t, ok)
+	assert.InDelta(t, 30*time.Second, retry, float64(time.Millisecond))
+
+	// other keys have their own bucket
+	ok, _, _ = s.Take(ctx, "u2", limit, now)
+	assert.True(t, ok)
+
+	ok, retry, _ = s.Take(ctx, "u1", limit, now.Add(20*time.Second))
+	assert.False(t, ok)
+	assert.InDelta(t, 10*time.Second, retry, float64(time.Millisecond))
+	ok, _, _ = s.Take(ctx, "u1", limit, now.Add(30*time.Second))
+	assert.True(t, ok)
+}
+
+func TestMemoryStoreSweep(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	limit := PerPeriod(60, time.Minute, 0)
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	s.Take(ctx, "a", limit, now)
+	s.Take(ctx, "b", limit, now)
+	assert.Equal(t, 2, s.Len())
+
+	// a minute later both buckets are full again and dropped; only "c" is kept
+	s.Take(ctx, "c", limit, now.Add(2*time.Minute))
+	assert.Equal(t, 1, s.Len())
+}
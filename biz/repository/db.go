@@ -14,7 +14,10 @@ func InitPostgres() {
     dsn := config.GetSpecificConfig().DB_DSN
 
     var err error
-    DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{})
+    DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
+        // 表之间的关系由代码维护（比如删帖时按顺序删投票 / 评论），AutoMigrate 不建外键
+        DisableForeignKeyConstraintWhenMigrating: true,
+    })
     if err != nil {
         log.Printf("failed to connect to postgres: %v", err)
     }
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+
+	"zetian-personal-website-hertz/biz/domain"
+)
+
+// models are all tables of the app. The schema (columns, generated columns, indexes)
+// is declared in the gorm tags of biz/domain, AutoMigrate turns it into DDL.
+var models = []interface{}{
+	&domain.User{},
+	&domain.UserStats{},
+	&domain.UserFollowRecord{},
+	&domain.EmailVerificationCode{},
+	&domain.School{},
+	&domain.Category{},
+
+	&domain.PostBase{},
+	&domain.PostStats{},
+	&domain.PostLike{},
+	&domain.PostFavorite{},
+	&domain.PostComment{},
+	&domain.PostCommentLike{},
+	&domain.Tag{},
+	&domain.PostTag{},
+	&domain.Poll{},
+	&domain.PollOption{},
+	&domain.PollVote{},
+	&domain.PollVoteChoice{},
+	&domain.PostMention{},
+	&domain.PostRevision{},
+	&domain.PostReport{},
+	&domain.ModerationAction{},
+}
+
+// AutoMigrate brings the database schema up to date with biz/domain: creates missing tables,
+// columns (e.g. post_bases.search_vector / geo_point, hidden, pinned_at) and indexes
+// (e.g. the GIN index of search and the GiST index of nearby). It never drops anything,
+// so running it on every start is safe. Call after InitPostgres.
+func AutoMigrate() error {
+	if DB == nil {
+		return fmt.Errorf("auto migrate: database is not connected")
+	}
+	if err := DB.AutoMigrate(models...); err != nil {
+		return fmt.Errorf("auto migrate: %w", err)
+	}
+	log.Println("PostgreSQL schema migrated.")
+	return nil
+}
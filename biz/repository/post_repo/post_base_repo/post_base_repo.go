@@ -5,9 +5,11 @@ import (
 	"time"
 
 	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/pkg/geo"
 	DB "zetian-personal-website-hertz/biz/repository"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 /*
@@ -24,6 +26,11 @@ func CreatePostBase(ctx context.Context, base *domain.PostBase) error {
 	return DB.DB.WithContext(ctx).Create(base).Error
 }
 
+// CreatePostBaseTx is CreatePostBase inside a transaction (e.g. post + poll).
+func CreatePostBaseTx(tx *gorm.DB, base *domain.PostBase) error {
+	return tx.Create(base).Error
+}
+
 
 // GetPostBaseByID returns the base post row.
 // No stats included.
@@ -37,6 +44,27 @@ func GetPostBaseByID(ctx context.Context, id int64) (*domain.PostBase, error) {
 }
 
 
+// GetPublishedPostBaseByID is GetPostBaseByID for posts others can interact with
+// (like / favorite / comment / quote): drafts and scheduled posts are not found.
+func GetPublishedPostBaseByID(ctx context.Context, id int64) (*domain.PostBase, error) {
+	var base domain.PostBase
+	err := DB.DB.WithContext(ctx).Scopes(published).First(&base, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &base, nil
+}
+
+// GetPublishedPostBasesByIDs returns published posts among ids (any order, missing ids are left out).
+func GetPublishedPostBasesByIDs(ctx context.Context, ids []int64) ([]domain.PostBase, error) {
+	var bases []domain.PostBase
+	if len(ids) == 0 {
+		return bases, nil
+	}
+	err := DB.DB.WithContext(ctx).Scopes(published).Where("id IN ?", ids).Find(&bases).Error
+	return bases, err
+}
+
 // UpdatePostBase ensures only the owner can update title/content.
 // UpdatedAt will auto-update via gorm hook.
 func UpdatePostBase(ctx context.Context, userID, postID int64, title, content string) error {
@@ -49,13 +77,143 @@ func UpdatePostBase(ctx context.Context, userID, postID int64, title, content st
 		}).Error
 }
 
+// GetOwnPostBaseForUpdateTx loads a post owned by userID and locks the row (SELECT ... FOR UPDATE)
+// until the transaction ends, so concurrent edits are serialized.
+func GetOwnPostBaseForUpdateTx(tx *gorm.DB, userID, postID int64) (*domain.PostBase, error) {
+	var base domain.PostBase
+	err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND user_id = ?", postID, userID).
+		Take(&base).Error
+	if err != nil {
+		return nil, err
+	}
+	return &base, nil
+}
+
+// ApplyEditTx writes the new title / content / tags and bumps edit_count.
+func ApplyEditTx(tx *gorm.DB, postID int64, title, content, tagsJSON string) error {
+	return tx.
+		Model(&domain.PostBase{}).
+		Where("id = ?", postID).
+		Updates(map[string]any{
+			"title":      title,
+			"content":    content,
+			"tags":       tagsJSON,
+			"edit_count": gorm.Expr("edit_count + 1"),
+		}).Error
+}
 
-// DeletePostBase deletes a post only if owner matches.
-// Note: post_stats row will be AUTO-deleted (ON DELETE CASCADE).
+
+
+// -----------------------------------------------------------------------------
+// Drafts / scheduled publishing
+// -----------------------------------------------------------------------------
+
+// UpdateDraftPostBase updates a draft / scheduled post of userID.
+// Returns gorm.ErrRecordNotFound if the post is not an unpublished post of userID.
+func UpdateDraftPostBase(ctx context.Context, userID, postID int64, fields map[string]any) error {
+	tx := DB.DB.WithContext(ctx).
+		Model(&domain.PostBase{}).
+		Where("id = ? AND user_id = ? AND status <> ?", postID, userID, domain.PostStatusPublished).
+		Updates(fields)
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// PublishPostBase turns a draft / scheduled post into a published one,
+// created_at and publish_at are set to now so the post shows up at the top of feeds.
+//   - userID > 0: the author publishes by hand (draft or scheduled).
+//   - userID <= 0: the scheduler, only scheduled posts whose publish_at <= now.
+// Returns gorm.ErrRecordNotFound if nothing was published (already published, not owned, not due...),
+// so concurrent publishers never publish the same post twice.
+func PublishPostBase(ctx context.Context, postID, userID int64, now time.Time) error {
+	return PublishPostBaseTx(DB.DB.WithContext(ctx), postID, userID, now)
+}
+
+// PublishPostBaseTx is PublishPostBase inside a transaction (publish + hold for review).
+func PublishPostBaseTx(tx *gorm.DB, postID, userID int64, now time.Time) error {
+	q := tx.
+		Model(&domain.PostBase{}).
+		Where("id = ?", postID)
+	if userID > 0 {
+		q = q.Where("user_id = ? AND status <> ?", userID, domain.PostStatusPublished)
+	} else {
+		q = q.Where("status = ? AND publish_at <= ?", domain.PostStatusScheduled, now)
+	}
+
+	res := q.Updates(map[string]any{
+		"status":     domain.PostStatusPublished,
+		"created_at": now,
+		"publish_at": now,
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListDraftsByUserID returns drafts and scheduled posts of a user, latest updated first.
+func ListDraftsByUserID(ctx context.Context, userID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	err := DB.DB.WithContext(ctx).
+		Where("user_id = ? AND status <> ?", userID, domain.PostStatusPublished).
+		Order("updated_at DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListDuePostIDs returns ids of scheduled posts whose publish_at <= now.
+func ListDuePostIDs(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	err := DB.DB.WithContext(ctx).
+		Model(&domain.PostBase{}).
+		Where("status = ? AND publish_at <= ?", domain.PostStatusScheduled, now).
+		Order("publish_at ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// DeletePostBase soft-deletes (moves to trash) a post only if owner matches.
+// Sets deleted_at and clears pinned_at in the same update, so a restored post is never an extra pin;
+// see PurgePostBaseTx for the real delete.
 func DeletePostBase(ctx context.Context, userID, postID int64) error {
 	tx := DB.DB.WithContext(ctx).
+		Model(&domain.PostBase{}).
 		Where("id = ? AND user_id = ?", postID, userID).
-		Delete(&domain.PostBase{})
+		UpdateColumns(map[string]interface{}{
+			"deleted_at": time.Now(),
+			"pinned_at":  nil,
+		})
+
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// RestorePostBase takes a post of userID out of the trash.
+// Returns gorm.ErrRecordNotFound if there is no such trashed post (posts removed by a moderator can't be restored).
+func RestorePostBase(ctx context.Context, userID, postID int64) error {
+	tx := DB.DB.WithContext(ctx).
+		Unscoped().
+		Model(&domain.PostBase{}).
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL AND NOT removed_by_moderator", postID, userID).
+		Update("deleted_at", nil)
 
 	if tx.Error != nil {
 		return tx.Error
@@ -66,37 +224,494 @@ func DeletePostBase(ctx context.Context, userID, postID int64) error {
 	return nil
 }
 
+// RemovePostByModeratorTx moves a post into the trash as removed by a moderator (already trashed posts
+// stay where they are but can no longer be restored). Also unpins it. The purger deletes it as usual.
+func RemovePostByModeratorTx(tx *gorm.DB, postID int64) error {
+	return tx.Unscoped().
+		Model(&domain.PostBase{}).
+		Where("id = ?", postID).
+		Updates(map[string]interface{}{
+			"removed_by_moderator": true,
+			"pinned_at":            nil,
+			"deleted_at":           gorm.Expr("COALESCE(deleted_at, ?)", time.Now()),
+		}).Error
+}
+
+// PurgePostBaseTx hard-deletes a post row (trashed or not).
+// Note: there is no FK cascade, the caller deletes the post_stats row in the same tx.
+func PurgePostBaseTx(tx *gorm.DB, postID int64) error {
+	return tx.
+		Unscoped().
+		Where("id = ?", postID).
+		Delete(&domain.PostBase{}).Error
+}
+
+// GetPostBaseByIDUnscoped is GetPostBaseByID that also sees trashed posts.
+func GetPostBaseByIDUnscoped(ctx context.Context, id int64) (*domain.PostBase, error) {
+	var base domain.PostBase
+	err := DB.DB.WithContext(ctx).Unscoped().First(&base, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &base, nil
+}
+
+// GetPostBasesByIDsUnscoped returns posts among ids including trashed ones
+// (any order, missing ids are left out). Used by the moderation queue.
+func GetPostBasesByIDsUnscoped(ctx context.Context, ids []int64) ([]domain.PostBase, error) {
+	var bases []domain.PostBase
+	if len(ids) == 0 {
+		return bases, nil
+	}
+	err := DB.DB.WithContext(ctx).Unscoped().Where("id IN ?", ids).Find(&bases).Error
+	return bases, err
+}
+
+// SetPostHiddenTx sets the moderation flag of a post (trashed posts included).
+// Hiding also unpins the post: a hidden pin would take one of the author's pin slots without showing anywhere.
+// Returns false if the flag already had that value, so concurrent hides only act once.
+func SetPostHiddenTx(tx *gorm.DB, postID int64, hidden bool) (bool, error) {
+	columns := map[string]interface{}{"hidden": hidden}
+	if hidden {
+		columns["pinned_at"] = nil
+	}
+	res := tx.Unscoped().
+		Model(&domain.PostBase{}).
+		Where("id = ? AND hidden = ?", postID, !hidden).
+		UpdateColumns(columns)
+	return res.RowsAffected == 1, res.Error
+}
+
+// ListTrashedPostsByUserID returns trashed posts of a user, latest deleted first.
+// Posts removed by a moderator are left out (the author can't restore them).
+func ListTrashedPostsByUserID(ctx context.Context, userID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	err := DB.DB.WithContext(ctx).
+		Unscoped().
+		Where("user_id = ? AND deleted_at IS NOT NULL AND NOT removed_by_moderator", userID).
+		Order("deleted_at DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListPostIDsTrashedBefore returns ids of posts trashed before cutoff (for the purger).
+func ListPostIDsTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	err := DB.DB.WithContext(ctx).
+		Unscoped().
+		Model(&domain.PostBase{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Order("id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
 // -----------------------------------------------------------------------------
 // List (for index / personal page / school page)
 // -----------------------------------------------------------------------------
 
+// published is the scope of every feed query: drafts / scheduled posts and posts hidden
+// by moderation are never listed (see domain.PostBase.IsListed).
+func published(db *gorm.DB) *gorm.DB {
+	return db.Where("post_bases.status = ? AND NOT post_bases.hidden", domain.PostStatusPublished)
+}
+
+// visibleTo keeps posts viewer is allowed to see (see domain.PostVisibility*):
+// public posts, viewer's own posts, followers-only posts of people viewer follows,
+// and school-only posts of viewer's school.
+func visibleTo(viewer domain.PostViewer) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewer.UserID <= 0 {
+			return db.Where("post_bases.visibility = ?", domain.PostVisibilityPublic)
+		}
+		return db.Where(
+			"(post_bases.visibility = ? OR post_bases.user_id = ?"+
+				" OR (post_bases.visibility = ? AND post_bases.user_id IN (SELECT followee_id FROM user_follow_records WHERE follower_id = ?))"+
+				" OR (post_bases.visibility = ? AND post_bases.school_id = ? AND post_bases.school_id <> 0))",
+			domain.PostVisibilityPublic, viewer.UserID,
+			domain.PostVisibilityFollowers, viewer.UserID,
+			domain.PostVisibilitySchool, viewer.SchoolID,
+		)
+	}
+}
+
+// Feeds below only return published posts viewer is allowed to see.
+
 // ListRecentPosts fetches most recent posts (base only).
-func ListRecentPosts(ctx context.Context, limit int) ([]domain.PostBase, error) {
+func ListRecentPosts(ctx context.Context, viewer domain.PostViewer, limit int) ([]domain.PostBase, error) {
 	var posts []domain.PostBase
 	err := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
 		Order("created_at DESC").
 		Limit(limit).
 		Find(&posts).Error
 	return posts, err
 }
 
-// ListPostsBySchoolIDBefore paginates by created_at for school feed.
-func ListPostsBySchoolIDBefore(ctx context.Context, schoolID int64, before time.Time, limit int) ([]domain.PostBase, error) {
+// Time-ordered feeds are ordered by (created_at DESC, id DESC) and paginated by keyset:
+//   - (before, beforeID) is the last row of previous page, rows strictly after it are returned.
+//   - beforeID <= 0 means only "created_at < before" (legacy `before` time string).
+
+// ListPostsBySchoolIDBefore paginates school feed.
+func ListPostsBySchoolIDBefore(ctx context.Context, viewer domain.PostViewer, schoolID int64, before time.Time, beforeID int64, limit int) ([]domain.PostBase, error) {
 	var posts []domain.PostBase
-	err := DB.DB.WithContext(ctx).
-		Where("school_id = ? AND created_at < ?", schoolID, before).
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Where("school_id = ?", schoolID)
+	err := keysetBefore(q, "created_at", "id", before, beforeID).
 		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListPostsBySchoolAndCategoryBefore paginates one board (category) of a school.
+// Backed by index idx_post_bases_school_category_created (school_id, category_id, created_at DESC).
+func ListPostsBySchoolAndCategoryBefore(ctx context.Context, viewer domain.PostViewer, schoolID, categoryID int64, before time.Time, beforeID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Where("school_id = ? AND category_id = ?", schoolID, categoryID)
+	err := keysetBefore(q, "created_at", "id", before, beforeID).
+		Order("created_at DESC").
+		Order("id DESC").
 		Limit(limit).
 		Find(&posts).Error
 	return posts, err
 }
 
 // ListPostsByUserIDBefore paginates user’s own posts.
-func ListPostsByUserIDBefore(ctx context.Context, userID int64, before time.Time, limit int) ([]domain.PostBase, error) {
+// Pinned posts are left out, they are listed by ListPinnedPostsByUserID.
+func ListPostsByUserIDBefore(ctx context.Context, viewer domain.PostViewer, userID int64, before time.Time, beforeID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Where("user_id = ? AND pinned_at IS NULL", userID)
+	err := keysetBefore(q, "created_at", "id", before, beforeID).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListPinnedPostsByUserID returns pinned posts of a user that viewer can see, latest pinned first.
+func ListPinnedPostsByUserID(ctx context.Context, viewer domain.PostViewer, userID int64) ([]domain.PostBase, error) {
 	var posts []domain.PostBase
 	err := DB.DB.WithContext(ctx).
-		Where("user_id = ? AND created_at < ?", userID, before).
+		Scopes(published, visibleTo(viewer)).
+		Where("user_id = ? AND pinned_at IS NOT NULL", userID).
+		Order("pinned_at DESC").
+		Find(&posts).Error
+	return posts, err
+}
+
+// CountPinnedPostsTx returns how many (not trashed) posts userID has pinned.
+// Trashed and hidden posts are never pinned (DeletePostBase / SetPostHiddenTx unpin them).
+func CountPinnedPostsTx(tx *gorm.DB, userID int64) (int64, error) {
+	var n int64
+	err := tx.Model(&domain.PostBase{}).
+		Where("user_id = ? AND pinned_at IS NOT NULL", userID).
+		Count(&n).Error
+	return n, err
+}
+
+// SetPostPinnedTx pins (pinnedAt != nil) or unpins (nil) a post owned by userID, trashed posts included.
+// Returns false if nothing changed (not owned, or already in that state).
+func SetPostPinnedTx(tx *gorm.DB, userID, postID int64, pinnedAt *time.Time) (bool, error) {
+	q := tx.Unscoped().
+		Model(&domain.PostBase{}).
+		Where("id = ? AND user_id = ?", postID, userID)
+	if pinnedAt != nil {
+		q = q.Where("pinned_at IS NULL")
+	} else {
+		q = q.Where("pinned_at IS NOT NULL")
+	}
+	res := q.UpdateColumn("pinned_at", pinnedAt)
+	return res.RowsAffected == 1, res.Error
+}
+
+// ListPostsByUserIDsBefore paginates posts written by any of userIDs (following feed).
+func ListPostsByUserIDsBefore(ctx context.Context, viewer domain.PostViewer, userIDs []int64, before time.Time, beforeID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	if len(userIDs) == 0 {
+		return posts, nil
+	}
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Where("user_id IN ?", userIDs)
+	err := keysetBefore(q, "created_at", "id", before, beforeID).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListPostsByTagIDBefore paginates posts of a tag (via post_tags).
+//   - schoolID <= 0 means all schools.
+//   - post_tags.created_at is a copy of post_bases.created_at.
+func ListPostsByTagIDBefore(ctx context.Context, viewer domain.PostViewer, tagID int64, schoolID int64, before time.Time, beforeID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Model(&domain.PostBase{}).
+		Select("post_bases.*").
+		Joins("JOIN post_tags ON post_tags.post_id = post_bases.id").
+		Where("post_tags.tag_id = ?", tagID)
+	if schoolID > 0 {
+		q = q.Where("post_tags.school_id = ?", schoolID)
+	}
+
+	err := keysetBefore(q, "post_tags.created_at", "post_tags.post_id", before, beforeID).
+		Order("post_tags.created_at DESC").
+		Order("post_tags.post_id DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListPostsMentioningUserBefore paginates posts that mention userID (via post_mentions).
+func ListPostsMentioningUserBefore(ctx context.Context, viewer domain.PostViewer, userID int64, before time.Time, beforeID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Where("EXISTS (SELECT 1 FROM post_mentions WHERE post_mentions.post_id = post_bases.id AND post_mentions.user_id = ?)", userID)
+	err := keysetBefore(q, "created_at", "id", before, beforeID).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListQuotesOfPostBefore paginates posts that quote postID (reply_to = postID).
+func ListQuotesOfPostBefore(ctx context.Context, viewer domain.PostViewer, postID int64, before time.Time, beforeID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Where("reply_to = ?", postID)
+	err := keysetBefore(q, "created_at", "id", before, beforeID).
 		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ReactedPostBase is a post together with the time a user liked / favorited it.
+type ReactedPostBase struct {
+	domain.PostBase
+	ReactedAt time.Time
+}
+
+// ListFavoritedPostsBefore paginates posts userID favorited, latest favorite first.
+// (before, beforePostID) is (favorited_at, post_id) of the last row of previous page.
+func ListFavoritedPostsBefore(ctx context.Context, viewer domain.PostViewer, userID int64, before time.Time, beforePostID int64, limit int) ([]ReactedPostBase, error) {
+	return listReactedPostsBefore(ctx, "post_favorites", viewer, userID, before, beforePostID, limit)
+}
+
+// ListLikedPostsBefore paginates posts userID liked, latest like first.
+func ListLikedPostsBefore(ctx context.Context, viewer domain.PostViewer, userID int64, before time.Time, beforePostID int64, limit int) ([]ReactedPostBase, error) {
+	return listReactedPostsBefore(ctx, "post_likes", viewer, userID, before, beforePostID, limit)
+}
+
+// listReactedPostsBefore joins post_bases with a (user_id, post_id, created_at) relation table.
+func listReactedPostsBefore(ctx context.Context, table string, viewer domain.PostViewer, userID int64, before time.Time, beforePostID int64, limit int) ([]ReactedPostBase, error) {
+	var posts []ReactedPostBase
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Model(&domain.PostBase{}).
+		Select("post_bases.*, r.created_at AS reacted_at").
+		Joins("JOIN "+table+" r ON r.post_id = post_bases.id AND r.user_id = ?", userID)
+	err := keysetBefore(q, "r.created_at", "r.post_id", before, beforePostID).
+		Order("r.created_at DESC").
+		Order("r.post_id DESC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// keysetBefore adds "(createdAtCol, idCol) < (before, beforeID)".
+func keysetBefore(q *gorm.DB, createdAtCol, idCol string, before time.Time, beforeID int64) *gorm.DB {
+	if beforeID <= 0 {
+		return q.Where(createdAtCol+" < ?", before)
+	}
+	return q.Where("("+createdAtCol+", "+idCol+") < (?, ?)", before, beforeID)
+}
+
+// ListPostBasesAfterID returns (id, school_id, tags, created_at) of posts with id > afterID, id ASC.
+// Used by batch jobs (e.g. tag backfill) to walk the whole table.
+func ListPostBasesAfterID(ctx context.Context, afterID int64, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	err := DB.DB.WithContext(ctx).
+		Scopes(published).
+		Select("id", "school_id", "tags", "created_at").
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListPostBasesCreatedAfter returns (id, user_id, created_at) of every post created after since.
+// Used by hot_score_service to recompute scores inside the scoring window.
+func ListPostBasesCreatedAfter(ctx context.Context, since time.Time) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+	err := DB.DB.WithContext(ctx).
+		Scopes(published).
+		Select("id", "user_id", "created_at").
+		Where("created_at >= ?", since).
+		Find(&posts).Error
+	return posts, err
+}
+
+// ListHotPosts pages through posts ordered by post_stats.hot_score DESC, id DESC.
+//   - schoolID <= 0 means all schools.
+//   - only posts published at or before snapshot are listed, so new posts don't shift the offsets
+//     of a paging session (see post_service.GetHotPosts).
+func ListHotPosts(
+	ctx context.Context,
+	viewer domain.PostViewer,
+	schoolID int64,
+	snapshot time.Time,
+	offset int,
+	limit int,
+) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Model(&domain.PostBase{}).
+		Select("post_bases.*").
+		Joins("JOIN post_stats ON post_stats.post_id = post_bases.id")
+
+	if schoolID > 0 {
+		q = q.Where("post_bases.school_id = ?", schoolID)
+	}
+	q = q.Where("COALESCE(post_bases.publish_at, post_bases.created_at) <= ?", snapshot)
+
+	err := q.
+		Order("post_stats.hot_score DESC").
+		Order("post_bases.id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// NearbyPostBase is a PostBase with its distance (meters) from the query point.
+type NearbyPostBase struct {
+	domain.PostBase `gorm:"embedded"`
+	Distance float64
+}
+
+// haversineExpr is the distance in meters between (latitude, longitude) of the row and (?, ?).
+// Vars: earth radius, lat, lat, lng — see haversineVars.
+const haversineExpr = "(? * 2 * asin(least(1, sqrt(" +
+	"power(sin(radians(post_bases.latitude - ?) / 2), 2) + " +
+	"cos(radians(?)) * cos(radians(post_bases.latitude)) * power(sin(radians(post_bases.longitude - ?) / 2), 2)))))"
+
+func haversineVars(lat, lng float64) []any {
+	return []any{geo.EarthRadiusM, lat, lat, lng}
+}
+
+// ListNearbyPosts paginates posts within radiusM meters of (lat, lng), nearest first (distance ASC, id ASC).
+//   - the GiST index on geo_point narrows rows to the bounding box, haversine does the exact cut.
+//   - (cursorDist, cursorID) is the last row of previous page; cursorID <= 0 means first page.
+func ListNearbyPosts(
+	ctx context.Context,
+	viewer domain.PostViewer,
+	lat, lng, radiusM float64,
+	cursorDist float64,
+	cursorID int64,
+	limit int,
+) ([]NearbyPostBase, error) {
+	var posts []NearbyPostBase
+
+	vars := haversineVars(lat, lng)
+	box := geo.BoundingBox(lat, lng, radiusM)
+
+	q := DB.DB.WithContext(ctx).
+		Scopes(published, visibleTo(viewer)).
+		Model(&domain.PostBase{}).
+		Select("post_bases.*, "+haversineExpr+" AS distance", vars...).
+		Where("post_bases.geo_point <@ box(point(?, ?), point(?, ?))", box.MinLng, box.MinLat, box.MaxLng, box.MaxLat).
+		Where(haversineExpr+" <= ?", append(vars, radiusM)...)
+	if cursorID > 0 {
+		q = q.Where("("+haversineExpr+", post_bases.id) > (?, ?)", append(vars, cursorDist, cursorID)...)
+	}
+
+	err := q.
+		Order("distance ASC, post_bases.id ASC").
+		Limit(limit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// PostSearchFilter holds optional filters of SearchPosts. Zero value means not filtered.
+type PostSearchFilter struct {
+	Query      string // websearch syntax, e.g. `exam "final week" -math`
+	SchoolID   int64
+	CategoryID int64
+	UserID     int64
+	Tag        string // normalized tag name (tag_service.NormalizeTag), matched through post_tags
+	Since      time.Time // created_at >= Since
+	Until      time.Time // created_at < Until
+}
+
+// SearchPosts runs full-text search on post_bases.search_vector.
+//   - Query != "": ordered by ts_rank DESC, id DESC
+//   - Query == "": filters only, ordered by created_at DESC, id DESC
+//   - offset / limit paginate the ranked result.
+func SearchPosts(ctx context.Context, viewer domain.PostViewer, filter PostSearchFilter, offset int, limit int) ([]domain.PostBase, error) {
+	var posts []domain.PostBase
+
+	q := DB.DB.WithContext(ctx).Scopes(published, visibleTo(viewer)).Model(&domain.PostBase{})
+
+	if filter.Query != "" {
+		q = q.Where("search_vector @@ websearch_to_tsquery('simple', ?)", filter.Query)
+	}
+	if filter.SchoolID > 0 {
+		q = q.Where("school_id = ?", filter.SchoolID)
+	}
+	if filter.CategoryID > 0 {
+		q = q.Where("category_id = ?", filter.CategoryID)
+	}
+	if filter.UserID > 0 {
+		q = q.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Tag != "" {
+		// 走 post_tags 而不是旧的 tags JSON 列，大小写 / '#' 已经在 NormalizeTag 里统一
+		q = q.Where(
+			"EXISTS (SELECT 1 FROM post_tags JOIN tags ON tags.id = post_tags.tag_id"+
+				" WHERE post_tags.post_id = post_bases.id AND tags.name = ?)",
+			filter.Tag,
+		)
+	}
+	if !filter.Since.IsZero() {
+		q = q.Where("created_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		q = q.Where("created_at < ?", filter.Until)
+	}
+
+	// 注意：带参数的 OrderBy.Expression 不能再和其他 Order() 合并，所以 id DESC 写在同一个表达式里
+	if filter.Query != "" {
+		q = q.Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:  "ts_rank(search_vector, websearch_to_tsquery('simple', ?)) DESC, id DESC",
+			Vars: []interface{}{filter.Query},
+		}})
+	} else {
+		q = q.Order("created_at DESC").Order("id DESC")
+	}
+
+	err := q.
+		Offset(offset).
 		Limit(limit).
 		Find(&posts).Error
 	return posts, err
@@ -0,0 +1,87 @@
+package post_comment_like_repo
+
+import (
+	"context"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LikeComment inserts a like relation. Idempotent: multiple calls are safe.
+func LikeComment(ctx context.Context, userID, commentID int64) error {
+	like := &domain.PostCommentLike{
+		UserID:    userID,
+		CommentID: commentID,
+		CreatedAt: time.Now(),
+	}
+
+	// ON CONFLICT(user_id, comment_id) DO NOTHING
+	return DB.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "comment_id"}},
+			DoNothing: true,
+		}).
+		Create(like).Error
+}
+
+// UnlikeComment removes a like relation. Idempotent.
+func UnlikeComment(ctx context.Context, userID, commentID int64) error {
+	return DB.DB.WithContext(ctx).
+		Where("user_id = ? AND comment_id = ?", userID, commentID).
+		Delete(&domain.PostCommentLike{}).Error
+}
+
+// HasUserLiked checks whether the user has liked the comment.
+func HasUserLiked(ctx context.Context, userID, commentID int64) (bool, error) {
+	var count int64
+	err := DB.DB.WithContext(ctx).
+		Model(&domain.PostCommentLike{}).
+		Where("user_id = ? AND comment_id = ?", userID, commentID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetUserLikedCommentIDs returns a set-like map[commentID]bool for given user and commentIDs.
+func GetUserLikedCommentIDs(ctx context.Context, userID int64, commentIDs []int64) (map[int64]bool, error) {
+	result := make(map[int64]bool)
+
+	if len(commentIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []domain.PostCommentLike
+	err := DB.DB.WithContext(ctx).
+		Where("user_id = ? AND comment_id IN ?", userID, commentIDs).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		result[r.CommentID] = true
+	}
+	return result, nil
+}
+
+// DeleteLikesByCommentIDs deletes all likes of the given comments.
+// Called before deleting the comments themselves.
+func DeleteLikesByCommentIDs(ctx context.Context, commentIDs []int64) error {
+	return DeleteLikesByCommentIDsTx(DB.DB.WithContext(ctx), commentIDs)
+}
+
+// DeleteLikesByCommentIDsTx is DeleteLikesByCommentIDs inside a transaction (DeleteComment).
+func DeleteLikesByCommentIDsTx(tx *gorm.DB, commentIDs []int64) error {
+	if len(commentIDs) == 0 {
+		return nil
+	}
+	return tx.
+		Where("comment_id IN ?", commentIDs).
+		Delete(&domain.PostCommentLike{}).Error
+}
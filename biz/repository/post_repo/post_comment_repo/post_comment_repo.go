@@ -0,0 +1,173 @@
+package post_comment_repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+)
+
+/*
+PostCommentRepo
+---------------
+This repo handles ONLY the post_comments table.
+Counters on post_stats (comment_count / last_comment_at) go to PostStatsRepo.
+*/
+
+// CreateCommentTx inserts a new comment row (in the same transaction as the counters).
+func CreateCommentTx(tx *gorm.DB, comment *domain.PostComment) error {
+	return tx.Create(comment).Error
+}
+
+// GetCommentByID returns one comment row.
+func GetCommentByID(ctx context.Context, id int64) (*domain.PostComment, error) {
+	var c domain.PostComment
+	err := DB.DB.WithContext(ctx).First(&c, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ListTopLevelComments paginates top-level comments of a post, oldest first.
+// cursor is the last comment ID of previous page (0 = first page).
+func ListTopLevelComments(
+	ctx context.Context,
+	postID int64,
+	cursor int64,
+	limit int,
+) (records []domain.PostComment, nextCursor int64, hasMore bool, err error) {
+	q := DB.DB.WithContext(ctx).
+		Where("post_id = ? AND root_id = 0", postID)
+	return listByIDAsc(q, cursor, limit)
+}
+
+// ListReplies paginates replies of a top-level comment, oldest first.
+func ListReplies(
+	ctx context.Context,
+	rootID int64,
+	cursor int64,
+	limit int,
+) (records []domain.PostComment, nextCursor int64, hasMore bool, err error) {
+	q := DB.DB.WithContext(ctx).
+		Where("root_id = ?", rootID)
+	return listByIDAsc(q, cursor, limit)
+}
+
+// listByIDAsc is the shared cursor pagination (id ASC, fetch limit+1 to detect hasMore).
+func listByIDAsc(
+	q *gorm.DB,
+	cursor int64,
+	limit int,
+) (records []domain.PostComment, nextCursor int64, hasMore bool, err error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	if cursor > 0 {
+		q = q.Where("id > ?", cursor)
+	}
+
+	var list []domain.PostComment
+	if err = q.Order("id ASC").Limit(limit + 1).Find(&list).Error; err != nil {
+		return
+	}
+
+	if len(list) == 0 {
+		return nil, 0, false, nil
+	}
+
+	if len(list) > limit {
+		records = list[:limit]
+		hasMore = true
+		nextCursor = list[limit-1].ID
+	} else {
+		records = list
+		hasMore = false
+		nextCursor = 0
+	}
+	return
+}
+
+// ListThreadCommentIDs returns commentID itself plus, if it is a top-level comment,
+// the IDs of all its replies. These are the rows removed by deleting commentID.
+func ListThreadCommentIDs(ctx context.Context, commentID int64) ([]int64, error) {
+	var ids []int64
+	err := DB.DB.WithContext(ctx).
+		Model(&domain.PostComment{}).
+		Where("id = ? OR root_id = ?", commentID, commentID).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// DeleteCommentsByIDsTx deletes comment rows by IDs (in the same transaction as the counters).
+func DeleteCommentsByIDsTx(tx *gorm.DB, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return tx.
+		Where("id IN ?", ids).
+		Delete(&domain.PostComment{}).Error
+}
+
+// ListCommentIDsByPostID returns IDs of all comments under a post.
+func ListCommentIDsByPostID(ctx context.Context, postID int64) ([]int64, error) {
+	var ids []int64
+	err := DB.DB.WithContext(ctx).
+		Model(&domain.PostComment{}).
+		Where("post_id = ?", postID).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// DeleteCommentsByPostID deletes all comments of a post.
+func DeleteCommentsByPostID(ctx context.Context, postID int64) error {
+	return DB.DB.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Delete(&domain.PostComment{}).Error
+}
+
+// GetLatestCommentTimeTx returns created_at of the newest comment of a post.
+// ok = false if the post has no comment.
+func GetLatestCommentTimeTx(tx *gorm.DB, postID int64) (t time.Time, ok bool, err error) {
+	var c domain.PostComment
+	err = tx.
+		Where("post_id = ?", postID).
+		Order("created_at DESC").
+		Take(&c).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	return c.CreatedAt, true, nil
+}
+
+// -----------------------------------------------------------------------------
+// Atomic Increment Helpers
+// -----------------------------------------------------------------------------
+
+func incrementColumn(ctx context.Context, commentID int64, column string, delta int32) error {
+	return incrementColumnTx(DB.DB.WithContext(ctx), commentID, column, delta)
+}
+
+func incrementColumnTx(tx *gorm.DB, commentID int64, column string, delta int32) error {
+	return tx.
+		Model(&domain.PostComment{}).
+		Where("id = ?", commentID).
+		Update(column, gorm.Expr(column+" + ?", delta)).
+		Error
+}
+
+// IncrementReplyCountTx changes reply_count of a thread root in the same transaction as the reply rows.
+func IncrementReplyCountTx(tx *gorm.DB, commentID int64, delta int32) error {
+	return incrementColumnTx(tx, commentID, "reply_count", delta)
+}
+
+func IncrementLikeCount(ctx context.Context, commentID int64, delta int32) error {
+	return incrementColumn(ctx, commentID, "like_count", delta)
+}
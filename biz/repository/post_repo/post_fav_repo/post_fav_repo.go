@@ -7,30 +7,32 @@ import (
 	"zetian-personal-website-hertz/biz/domain"
 	DB "zetian-personal-website-hertz/biz/repository"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
-// FavoritePost inserts a favorite relation. Idempotent.
-func FavoritePost(ctx context.Context, userID, postID int64) error {
+// FavoritePostTx inserts a favorite relation. Idempotent.
+// Returns false if the user had already favorited the post (nothing is written then).
+func FavoritePostTx(tx *gorm.DB, userID, postID int64) (bool, error) {
 	fav := &domain.PostFavorite{
 		UserID:    userID,
 		PostID:    postID,
 		CreatedAt: time.Now(),
 	}
 
-	return DB.DB.WithContext(ctx).
-		Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "user_id"}, {Name: "post_id"}},
-			DoNothing: true,
-		}).
-		Create(fav).Error
+	res := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "post_id"}},
+		DoNothing: true,
+	}).Create(fav)
+	return res.RowsAffected == 1, res.Error
 }
 
-// UnfavoritePost removes a favorite relation. Idempotent.
-func UnfavoritePost(ctx context.Context, userID, postID int64) error {
-	return DB.DB.WithContext(ctx).
-		Where("user_id = ? AND post_id = ?", userID, postID).
-		Delete(&domain.PostFavorite{}).Error
+// UnfavoritePostTx removes a favorite relation. Idempotent.
+// Returns whether a favorite was removed.
+func UnfavoritePostTx(tx *gorm.DB, userID, postID int64) (bool, error) {
+	res := tx.Where("user_id = ? AND post_id = ?", userID, postID).
+		Delete(&domain.PostFavorite{})
+	return res.RowsAffected == 1, res.Error
 }
 
 // HasUserFavorited checks whether the user has favorited the post.
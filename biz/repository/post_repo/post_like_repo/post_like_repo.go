@@ -7,11 +7,14 @@ import (
 	"zetian-personal-website-hertz/biz/domain"
 	DB "zetian-personal-website-hertz/biz/repository"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
-// LikePost inserts a like relation. Idempotent: multiple calls are safe.
-func LikePost(ctx context.Context, userID, postID int64) error {
+// LikePostTx inserts a like relation. Idempotent: multiple calls are safe.
+// Returns false if the user had already liked the post (nothing is written then),
+// so of two concurrent likes by the same user only one reports true.
+func LikePostTx(tx *gorm.DB, userID, postID int64) (bool, error) {
 	like := &domain.PostLike{
 		UserID:    userID,
 		PostID:    postID,
@@ -19,19 +22,36 @@ func LikePost(ctx context.Context, userID, postID int64) error {
 	}
 
 	// ON CONFLICT(user_id, post_id) DO NOTHING
-	return DB.DB.WithContext(ctx).
-		Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "user_id"}, {Name: "post_id"}},
-			DoNothing: true,
-		}).
-		Create(like).Error
+	res := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "post_id"}},
+		DoNothing: true,
+	}).Create(like)
+	return res.RowsAffected == 1, res.Error
 }
 
-// UnlikePost removes a like relation. Idempotent: deleting non-existing row is ok.
-func UnlikePost(ctx context.Context, userID, postID int64) error {
-	return DB.DB.WithContext(ctx).
-		Where("user_id = ? AND post_id = ?", userID, postID).
-		Delete(&domain.PostLike{}).Error
+// UnlikePostTx removes a like relation. Idempotent: deleting non-existing row is ok.
+// Returns whether a like was removed.
+func UnlikePostTx(tx *gorm.DB, userID, postID int64) (bool, error) {
+	res := tx.Where("user_id = ? AND post_id = ?", userID, postID).
+		Delete(&domain.PostLike{})
+	return res.RowsAffected == 1, res.Error
+}
+
+// ListLikersBefore returns likes of a post, latest first. Likes of deleted users are left out.
+// (before, beforeUserID) is (created_at, user_id) of the last row of previous page; beforeUserID <= 0 means first page.
+func ListLikersBefore(ctx context.Context, postID int64, before time.Time, beforeUserID int64, limit int) ([]domain.PostLike, error) {
+	var likes []domain.PostLike
+	q := DB.DB.WithContext(ctx).
+		Joins("JOIN users ON users.id = post_likes.user_id AND users.deleted_at IS NULL").
+		Where("post_likes.post_id = ?", postID)
+	if beforeUserID > 0 {
+		q = q.Where("(post_likes.created_at, post_likes.user_id) < (?, ?)", before, beforeUserID)
+	}
+	err := q.Order("post_likes.created_at DESC").
+		Order("post_likes.user_id DESC").
+		Limit(limit).
+		Find(&likes).Error
+	return likes, err
 }
 
 // HasUserLiked checks whether the user has liked the post.
@@ -86,6 +106,37 @@ func CountLikesBatch(ctx context.Context, postIDs []int64) (map[int64]int, error
 	return m, nil
 }
 
+// CountLikesReceivedBatch returns how many likes the posts of each user got, key = author user_id.
+// Posts in the trash still count (their likes are kept until purge).
+func CountLikesReceivedBatch(ctx context.Context, userIDs []int64) (map[int64]int, error) {
+	if len(userIDs) == 0 {
+		return map[int64]int{}, nil
+	}
+
+	type result struct {
+		UserID int64
+		Count  int64
+	}
+
+	var rows []result
+	err := DB.DB.WithContext(ctx).
+		Table("post_likes").
+		Select("post_bases.user_id AS user_id, COUNT(*) AS count").
+		Joins("JOIN post_bases ON post_bases.id = post_likes.post_id").
+		Where("post_bases.user_id IN ?", userIDs).
+		Group("post_bases.user_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[int64]int, len(rows))
+	for _, r := range rows {
+		m[r.UserID] = int(r.Count)
+	}
+	return m, nil
+}
+
 // GetUserLikedPostIDs returns a set-like map[postID]bool for given user and postIDs.
 func GetUserLikedPostIDs(ctx context.Context, userID int64, postIDs []int64) (map[int64]bool, error) {
 	result := make(map[int64]bool)
@@ -0,0 +1,57 @@
+package post_mention_repo
+
+import (
+	"context"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+)
+
+// ReplacePostMentions sets the mentions of a post to exactly rows (in one transaction).
+func ReplacePostMentions(ctx context.Context, postID int64, rows []domain.PostMention) error {
+	return DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return ReplacePostMentionsTx(tx, postID, rows)
+	})
+}
+
+// ReplacePostMentionsTx is ReplacePostMentions inside tx.
+func ReplacePostMentionsTx(tx *gorm.DB, postID int64, rows []domain.PostMention) error {
+	if err := tx.Where("post_id = ?", postID).Delete(&domain.PostMention{}).Error; err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return tx.Create(&rows).Error
+}
+
+// ListMentionsByPostIDs returns mentions of the given posts, key = post_id, each ordered by start.
+func ListMentionsByPostIDs(ctx context.Context, postIDs []int64) (map[int64][]domain.PostMention, error) {
+	res := make(map[int64][]domain.PostMention)
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+
+	var rows []domain.PostMention
+	err := DB.DB.WithContext(ctx).
+		Where("post_id IN ?", postIDs).
+		Order("post_id ASC, start ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range rows {
+		res[m.PostID] = append(res[m.PostID], m)
+	}
+	return res, nil
+}
+
+// DeleteMentionsByPostID removes all mentions of a post.
+func DeleteMentionsByPostID(ctx context.Context, postID int64) error {
+	return DB.DB.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Delete(&domain.PostMention{}).Error
+}
@@ -0,0 +1,129 @@
+package post_poll_repo
+
+import (
+	"context"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreatePollTx inserts a poll and its Options (gorm creates the has-many rows in the same tx).
+func CreatePollTx(tx *gorm.DB, poll *domain.Poll) error {
+	return tx.Create(poll).Error
+}
+
+// GetPollByPostID returns the poll of a post with options (position ASC).
+func GetPollByPostID(ctx context.Context, postID int64) (*domain.Poll, error) {
+	var poll domain.Poll
+	err := DB.DB.WithContext(ctx).
+		Preload("Options", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		First(&poll, "post_id = ?", postID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &poll, nil
+}
+
+// GetPollsByPostIDs returns polls of the given posts, key = post_id.
+// Posts without poll are not in the map.
+func GetPollsByPostIDs(ctx context.Context, postIDs []int64) (map[int64]*domain.Poll, error) {
+	res := make(map[int64]*domain.Poll)
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+
+	var polls []domain.Poll
+	err := DB.DB.WithContext(ctx).
+		Preload("Options", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Where("post_id IN ?", postIDs).
+		Find(&polls).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range polls {
+		res[polls[i].PostID] = &polls[i]
+	}
+	return res, nil
+}
+
+// GetUserChoicesByPostIDs returns the options userID voted for, key = post_id.
+// A post is in the map only if the user has voted on it.
+func GetUserChoicesByPostIDs(ctx context.Context, userID int64, postIDs []int64) (map[int64][]int64, error) {
+	res := make(map[int64][]int64)
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+
+	var choices []domain.PollVoteChoice
+	err := DB.DB.WithContext(ctx).
+		Where("user_id = ? AND post_id IN ?", userID, postIDs).
+		Order("option_id ASC").
+		Find(&choices).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range choices {
+		res[c.PostID] = append(res[c.PostID], c.OptionID)
+	}
+	return res, nil
+}
+
+// CreateVoteTx records that userID voted on the poll of postID.
+// Returns false if the user had already voted (primary key conflict), nothing is written then.
+func CreateVoteTx(tx *gorm.DB, postID, userID int64) (bool, error) {
+	res := tx.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.PollVote{PostID: postID, UserID: userID})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// CreateVoteChoicesTx saves the options chosen in a vote.
+func CreateVoteChoicesTx(tx *gorm.DB, choices []domain.PollVoteChoice) error {
+	if len(choices) == 0 {
+		return nil
+	}
+	return tx.Create(&choices).Error
+}
+
+// IncrementOptionVotesTx adds one vote to each of optionIDs of the poll (atomic, safe under concurrent votes).
+// Returns how many options were updated.
+func IncrementOptionVotesTx(tx *gorm.DB, postID int64, optionIDs []int64) (int64, error) {
+	res := tx.Model(&domain.PollOption{}).
+		Where("post_id = ? AND id IN ?", postID, optionIDs).
+		UpdateColumn("vote_count", gorm.Expr("vote_count + ?", 1))
+	return res.RowsAffected, res.Error
+}
+
+// IncrementTotalVotersTx adds one voter to the poll.
+func IncrementTotalVotersTx(tx *gorm.DB, postID int64) error {
+	return tx.Model(&domain.Poll{}).
+		Where("post_id = ?", postID).
+		UpdateColumn("total_voters", gorm.Expr("total_voters + ?", 1)).Error
+}
+
+// DeletePollByPostID removes the poll of a post with its options and votes.
+func DeletePollByPostID(ctx context.Context, postID int64) error {
+	return DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("post_id = ?", postID).Delete(&domain.PollVoteChoice{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("post_id = ?", postID).Delete(&domain.PollVote{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("post_id = ?", postID).Delete(&domain.PollOption{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("post_id = ?", postID).Delete(&domain.Poll{}).Error
+	})
+}
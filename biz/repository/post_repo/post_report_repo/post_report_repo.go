@@ -0,0 +1,172 @@
+package post_report_repo
+
+import (
+	"context"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/*
+PostReportRepo
+--------------
+post_reports (user reports) and moderation_actions (audit trail of moderators / the system).
+*/
+
+// CreateReport inserts a report. Idempotent: returns false if the user had already
+// reported the post ((post_id, reporter_id) conflict), nothing is written then.
+func CreateReport(ctx context.Context, report *domain.PostReport) (bool, error) {
+	res := DB.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "post_id"}, {Name: "reporter_id"}},
+			DoNothing: true,
+		}).
+		Create(report)
+	return res.RowsAffected == 1, res.Error
+}
+
+// UpsertSystemReportTx files the system report of a post (ReporterID 0). A post has at most
+// one system report: filing again reopens it with the new reason / detail.
+func UpsertSystemReportTx(tx *gorm.DB, report *domain.PostReport) error {
+	report.ReporterID = 0
+	report.CreatedAt = time.Now()
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "post_id"}, {Name: "reporter_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "detail", "status", "resolved_by", "resolved_at", "created_at"}),
+	}).Create(report).Error
+}
+
+// CountPendingReports returns how many reports of a post are waiting for a moderator.
+func CountPendingReports(ctx context.Context, postID int64) (int64, error) {
+	var n int64
+	err := DB.DB.WithContext(ctx).
+		Model(&domain.PostReport{}).
+		Where("post_id = ? AND status = ?", postID, domain.ReportStatusPending).
+		Count(&n).Error
+	return n, err
+}
+
+// PendingPost is a post with pending reports, as grouped by ListPendingReportedPosts.
+type PendingPost struct {
+	PostID          int64
+	PendingReports  int32
+	FirstReportedAt time.Time
+}
+
+// ListPendingReportedPosts returns posts with pending reports, oldest first report first.
+// (after, afterPostID) is (first_reported_at, post_id) of the last row of previous page;
+// afterPostID <= 0 means first page.
+func ListPendingReportedPosts(ctx context.Context, after time.Time, afterPostID int64, limit int) ([]PendingPost, error) {
+	var rows []PendingPost
+	q := DB.DB.WithContext(ctx).
+		Model(&domain.PostReport{}).
+		Select("post_id, COUNT(*) AS pending_reports, MIN(created_at) AS first_reported_at").
+		Where("status = ?", domain.ReportStatusPending).
+		Group("post_id")
+	if afterPostID > 0 {
+		q = q.Having("(MIN(created_at), post_id) > (?, ?)", after, afterPostID)
+	}
+	err := q.Order("first_reported_at ASC").
+		Order("post_id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// CountPendingReasonsByPostIDs returns pending report counts per reason, key = post_id.
+func CountPendingReasonsByPostIDs(ctx context.Context, postIDs []int64) (map[int64]map[string]int32, error) {
+	res := make(map[int64]map[string]int32)
+	if len(postIDs) == 0 {
+		return res, nil
+	}
+
+	var rows []struct {
+		PostID int64
+		Reason string
+		Cnt    int32
+	}
+	err := DB.DB.WithContext(ctx).
+		Model(&domain.PostReport{}).
+		Select("post_id, reason, COUNT(*) AS cnt").
+		Where("status = ? AND post_id IN ?", domain.ReportStatusPending, postIDs).
+		Group("post_id, reason").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		if res[r.PostID] == nil {
+			res[r.PostID] = make(map[string]int32)
+		}
+		res[r.PostID][r.Reason] = r.Cnt
+	}
+	return res, nil
+}
+
+// ResolveReportsTx closes every pending report of a post with status (dismissed / actioned).
+// Returns how many reports were closed.
+func ResolveReportsTx(tx *gorm.DB, postID int64, status string, moderatorID int64, now time.Time) (int64, error) {
+	res := tx.Model(&domain.PostReport{}).
+		Where("post_id = ? AND status = ?", postID, domain.ReportStatusPending).
+		Updates(map[string]any{
+			"status":      status,
+			"resolved_by": moderatorID,
+			"resolved_at": now,
+		})
+	return res.RowsAffected, res.Error
+}
+
+// DeleteReportsByPostID removes all reports of a post (when the post is purged).
+// moderation_actions are kept as the audit trail.
+func DeleteReportsByPostID(ctx context.Context, postID int64) error {
+	return DB.DB.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Delete(&domain.PostReport{}).Error
+}
+
+// CreateModerationAction appends a row to the audit trail.
+func CreateModerationAction(ctx context.Context, action *domain.ModerationAction) error {
+	return CreateModerationActionTx(DB.DB.WithContext(ctx), action)
+}
+
+// CreateModerationActionTx is CreateModerationAction inside a transaction.
+func CreateModerationActionTx(tx *gorm.DB, action *domain.ModerationAction) error {
+	return tx.Create(action).Error
+}
+
+// ListModerationActions returns audit rows, latest first.
+//   - postID > 0 keeps actions on that post only.
+//   - beforeID is the id of the last row of previous page, <= 0 means first page.
+func ListModerationActions(ctx context.Context, postID, beforeID int64, limit int) ([]domain.ModerationAction, error) {
+	var actions []domain.ModerationAction
+	q := DB.DB.WithContext(ctx)
+	if postID > 0 {
+		q = q.Where("post_id = ?", postID)
+	}
+	if beforeID > 0 {
+		q = q.Where("id < ?", beforeID)
+	}
+	err := q.Order("id DESC").
+		Limit(limit).
+		Find(&actions).Error
+	return actions, err
+}
+
+// ListModerationActionsByTargetUser returns actions on posts of userID, latest first.
+// beforeID is the id of the last row of the previous page, 0 for the first page.
+func ListModerationActionsByTargetUser(ctx context.Context, userID, beforeID int64, limit int) ([]domain.ModerationAction, error) {
+	var actions []domain.ModerationAction
+	q := DB.DB.WithContext(ctx).Where("target_user_id = ?", userID)
+	if beforeID > 0 {
+		q = q.Where("id < ?", beforeID)
+	}
+	err := q.Order("id DESC").
+		Limit(limit).
+		Find(&actions).Error
+	return actions, err
+}
@@ -0,0 +1,32 @@
+package post_revision_repo
+
+import (
+	"context"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+)
+
+// CreateRevisionTx saves an earlier version of a post, inside the edit transaction.
+func CreateRevisionTx(tx *gorm.DB, rev *domain.PostRevision) error {
+	return tx.Create(rev).Error
+}
+
+// ListRevisionsByPostID returns all earlier versions of a post, version ASC.
+func ListRevisionsByPostID(ctx context.Context, postID int64) ([]domain.PostRevision, error) {
+	var revs []domain.PostRevision
+	err := DB.DB.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Order("version ASC").
+		Find(&revs).Error
+	return revs, err
+}
+
+// DeleteRevisionsByPostID removes the edit history of a post.
+func DeleteRevisionsByPostID(ctx context.Context, postID int64) error {
+	return DB.DB.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Delete(&domain.PostRevision{}).Error
+}
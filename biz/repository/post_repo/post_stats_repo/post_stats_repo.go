@@ -2,6 +2,8 @@ package post_stats_repo
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"zetian-personal-website-hertz/biz/domain"
 	DB "zetian-personal-website-hertz/biz/repository"
@@ -18,12 +20,16 @@ import (
 // CreateEmptyStats creates a stats row for a post after creating PostBase.
 // If already exists, FirstOrCreate will simply load existing row.
 func CreateEmptyStats(ctx context.Context, postID int64) error {
+	return CreateEmptyStatsTx(DB.DB.WithContext(ctx), postID)
+}
+
+// CreateEmptyStatsTx is CreateEmptyStats inside tx (CreatePost creates the post and its stats together).
+func CreateEmptyStatsTx(tx *gorm.DB, postID int64) error {
 	stats := domain.PostStats{
 		PostID: postID,
 		// DB defaults handle all zero values
 	}
-	return DB.DB.WithContext(ctx).
-		FirstOrCreate(&stats, "post_id = ?", postID).Error
+	return tx.FirstOrCreate(&stats, "post_id = ?", postID).Error
 }
 
 // -----------------------------------------------------------------------------
@@ -65,6 +71,18 @@ func GetStatsBatch(ctx context.Context, postIDs []int64) (map[int64]*domain.Post
 // Update
 // -----------------------------------------------------------------------------
 
+// ListStatsAfterPostID returns stats rows with post_id > afterPostID, post_id ASC.
+// Used by batch jobs (e.g. counter reconciliation) to walk the whole table.
+func ListStatsAfterPostID(ctx context.Context, afterPostID int64, limit int) ([]domain.PostStats, error) {
+	var list []domain.PostStats
+	err := DB.DB.WithContext(ctx).
+		Where("post_id > ?", afterPostID).
+		Order("post_id ASC").
+		Limit(limit).
+		Find(&list).Error
+	return list, err
+}
+
 // UpdateStats updates all mutable fields in PostStats.
 func UpdateStats(ctx context.Context, stats *domain.PostStats) error {
 	return DB.DB.WithContext(ctx).
@@ -80,8 +98,12 @@ func UpdateStats(ctx context.Context, stats *domain.PostStats) error {
 // incrementColumn is a shared helper for atomic increments.
 // Uses gorm.Expr("col = col + ?") for thread-safe increments.
 func incrementColumn(ctx context.Context, postID int64, column string, delta int32) error {
-	return DB.DB.WithContext(ctx).
-		Model(&domain.PostStats{}).
+	return incrementColumnTx(DB.DB.WithContext(ctx), postID, column, delta)
+}
+
+// incrementColumnTx is incrementColumn inside a caller's transaction.
+func incrementColumnTx(tx *gorm.DB, postID int64, column string, delta int32) error {
+	return tx.Model(&domain.PostStats{}).
 		Where("post_id = ?", postID).
 		Update(column, gorm.Expr(column+" + ?", delta)).
 		Error
@@ -91,30 +113,107 @@ func IncrementView(ctx context.Context, postID int64, delta int32) error {
 	return incrementColumn(ctx, postID, "view_count", delta)
 }
 
-func IncrementLike(ctx context.Context, postID int64, delta int32) error {
-	return incrementColumn(ctx, postID, "like_count", delta)
+// IncrementLikeTx changes like_count in the same transaction as the post_likes row.
+func IncrementLikeTx(tx *gorm.DB, postID int64, delta int32) error {
+	return incrementColumnTx(tx, postID, "like_count", delta)
 }
 
-func IncrementFav(ctx context.Context, postID int64, delta int32) error {
-	return incrementColumn(ctx, postID, "fav_count", delta)
+// IncrementFavTx changes fav_count in the same transaction as the post_favorites row.
+func IncrementFavTx(tx *gorm.DB, postID int64, delta int32) error {
+	return incrementColumnTx(tx, postID, "fav_count", delta)
 }
 
-func IncrementComment(ctx context.Context, postID int64, delta int32) error {
-	return incrementColumn(ctx, postID, "comment_count", delta)
+// IncrementCommentTx changes comment_count in the same transaction as the post_comments rows.
+func IncrementCommentTx(tx *gorm.DB, postID int64, delta int32) error {
+	return incrementColumnTx(tx, postID, "comment_count", delta)
 }
 
 func IncrementShare(ctx context.Context, postID int64, delta int32) error {
 	return incrementColumn(ctx, postID, "share_count", delta)
 }
 
+// IncrementViewsBatch adds deltas[postID] to view_count of every post in one UPDATE
+// (UPDATE ... FROM (VALUES ...)), posts without stats row are skipped.
+func IncrementViewsBatch(ctx context.Context, deltas map[int64]int32) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(deltas))
+	args := make([]any, 0, 2*len(deltas))
+	for postID, delta := range deltas {
+		values = append(values, "(?::bigint, ?::integer)")
+		args = append(args, postID, delta)
+	}
+
+	return DB.DB.WithContext(ctx).Exec(
+		"UPDATE post_stats SET view_count = post_stats.view_count + v.delta"+
+			" FROM (VALUES "+strings.Join(values, ", ")+") AS v(post_id, delta)"+
+			" WHERE post_stats.post_id = v.post_id",
+		args...,
+	).Error
+}
+
+// RecountLikesAndFavs sets like_count / fav_count of a post to the number of rows in
+// post_likes / post_favorites, counted inside the UPDATE so a like landing meanwhile is not lost.
+func RecountLikesAndFavs(ctx context.Context, postID int64) error {
+	return DB.DB.WithContext(ctx).
+		Model(&domain.PostStats{}).
+		Where("post_id = ?", postID).
+		Updates(map[string]any{
+			"like_count": gorm.Expr("(SELECT COUNT(*) FROM post_likes WHERE post_likes.post_id = post_stats.post_id)"),
+			"fav_count":  gorm.Expr("(SELECT COUNT(*) FROM post_favorites WHERE post_favorites.post_id = post_stats.post_id)"),
+		}).Error
+}
+
+// SetLastCommentAtTx overwrites last_comment_at (unix seconds, 0 = no comment)
+// in the same transaction as the post_comments rows.
+func SetLastCommentAtTx(tx *gorm.DB, postID int64, ts int64) error {
+	return tx.
+		Model(&domain.PostStats{}).
+		Where("post_id = ?", postID).
+		Update("last_comment_at", ts).
+		Error
+}
+
 // -----------------------------------------------------------------------------
 // Delete
 // -----------------------------------------------------------------------------
 
-// DeleteStats deletes a stats row.
-// Note: This usually won't be needed if foreign key cascade is enabled.
-func DeleteStats(ctx context.Context, postID int64) error {
-	return DB.DB.WithContext(ctx).
+// DeleteStatsTx deletes a stats row in the same transaction as the post row.
+// AutoMigrate creates no foreign keys (DisableForeignKeyConstraintWhenMigrating),
+// so nothing cascades and PurgePost has to call this itself.
+func DeleteStatsTx(tx *gorm.DB, postID int64) error {
+	return tx.
 		Where("post_id = ?", postID).
 		Delete(&domain.PostStats{}).Error
 }
+
+// -----------------------------------------------------------------------------
+// Hot Score
+// -----------------------------------------------------------------------------
+
+// UpdateHotScore overwrites hot_score of a post (computed by hot_score_service).
+func UpdateHotScore(ctx context.Context, postID int64, score int64) error {
+	return DB.DB.WithContext(ctx).
+		Model(&domain.PostStats{}).
+		Where("post_id = ?", postID).
+		Update("hot_score", score).
+		Error
+}
+
+// ResetHotScoreForPostsBefore sets hot_score = 0 for posts created before cutoff.
+// Posts outside the scoring window are not recomputed anymore, so their stale
+// score must not keep them on the hot feed.
+func ResetHotScoreForPostsBefore(ctx context.Context, cutoff time.Time) error {
+	oldPostIDs := DB.DB.WithContext(ctx).
+		Model(&domain.PostBase{}).
+		Select("id").
+		Where("created_at < ?", cutoff)
+
+	return DB.DB.WithContext(ctx).
+		Model(&domain.PostStats{}).
+		Where("hot_score <> 0 AND post_id IN (?)", oldPostIDs).
+		Update("hot_score", 0).
+		Error
+}
@@ -0,0 +1,149 @@
+package tag_repo
+
+import (
+	"context"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+/*
+TagRepo
+-------
+Handles tags + post_tags tables.
+Names passed in here must already be normalized (see tag_service.NormalizeTags).
+*/
+
+// GetOrCreateTags makes sure every name has a row in tags, returns name -> Tag.
+// Concurrent creation of the same name is safe (ON CONFLICT DO NOTHING + reload).
+func GetOrCreateTags(ctx context.Context, names []string) (map[string]domain.Tag, error) {
+	return GetOrCreateTagsTx(DB.DB.WithContext(ctx), names)
+}
+
+// GetOrCreateTagsTx is GetOrCreateTags inside tx.
+func GetOrCreateTagsTx(tx *gorm.DB, names []string) (map[string]domain.Tag, error) {
+	res := make(map[string]domain.Tag, len(names))
+	if len(names) == 0 {
+		return res, nil
+	}
+
+	now := time.Now()
+	rows := make([]domain.Tag, 0, len(names))
+	for _, n := range names {
+		rows = append(rows, domain.Tag{Name: n, CreatedAt: now})
+	}
+
+	err := tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "name"}},
+			DoNothing: true,
+		}).
+		Create(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []domain.Tag
+	if err := tx.Where("name IN ?", names).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		res[t.Name] = t
+	}
+	return res, nil
+}
+
+// GetTagByName returns gorm.ErrRecordNotFound if the tag was never used.
+func GetTagByName(ctx context.Context, name string) (*domain.Tag, error) {
+	var tag domain.Tag
+	err := DB.DB.WithContext(ctx).First(&tag, "name = ?", name).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// ReplacePostTags sets the tags of a post to exactly tagIDs (delete + insert in one transaction).
+func ReplacePostTags(ctx context.Context, postID, schoolID int64, createdAt time.Time, tagIDs []int64) error {
+	return DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return ReplacePostTagsTx(tx, postID, schoolID, createdAt, tagIDs)
+	})
+}
+
+// ReplacePostTagsTx is ReplacePostTags inside tx.
+func ReplacePostTagsTx(tx *gorm.DB, postID, schoolID int64, createdAt time.Time, tagIDs []int64) error {
+	if err := tx.Where("post_id = ?", postID).Delete(&domain.PostTag{}).Error; err != nil {
+		return err
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]domain.PostTag, 0, len(tagIDs))
+	for _, id := range tagIDs {
+		rows = append(rows, domain.PostTag{
+			PostID:    postID,
+			TagID:     id,
+			SchoolID:  schoolID,
+			CreatedAt: createdAt,
+		})
+	}
+	return tx.
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&rows).Error
+}
+
+// DeletePostTagsByPostID removes all tag relations of a post.
+func DeletePostTagsByPostID(ctx context.Context, postID int64) error {
+	return DB.DB.WithContext(ctx).
+		Where("post_id = ?", postID).
+		Delete(&domain.PostTag{}).Error
+}
+
+// CountTagUsage counts posts per tag with created_at in [since, until).
+//   - schoolID <= 0 means all schools.
+//   - Returns tag_id -> count.
+func CountTagUsage(ctx context.Context, schoolID int64, since, until time.Time) (map[int64]int64, error) {
+	type row struct {
+		TagID int64
+		Cnt   int64
+	}
+	var rows []row
+
+	q := DB.DB.WithContext(ctx).
+		Model(&domain.PostTag{}).
+		Select("tag_id, COUNT(*) AS cnt").
+		Where("created_at >= ? AND created_at < ?", since, until)
+	if schoolID > 0 {
+		q = q.Where("school_id = ?", schoolID)
+	}
+	if err := q.Group("tag_id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	res := make(map[int64]int64, len(rows))
+	for _, r := range rows {
+		res[r.TagID] = r.Cnt
+	}
+	return res, nil
+}
+
+// GetTagsByIDs returns tag_id -> Tag.
+func GetTagsByIDs(ctx context.Context, ids []int64) (map[int64]domain.Tag, error) {
+	res := make(map[int64]domain.Tag, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	var tags []domain.Tag
+	if err := DB.DB.WithContext(ctx).Where("id IN ?", ids).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+	for _, t := range tags {
+		res[t.ID] = t
+	}
+	return res, nil
+}
@@ -5,23 +5,30 @@ import (
 
 	"zetian-personal-website-hertz/biz/domain"
 	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-// Follow 插入一条关注关系（不做幂等判断）
-// 幂等逻辑在 service 里面用 IsFollowing 控制
-func Follow(ctx context.Context, followerID, followeeID int64) error {
+// FollowTx 插入一条关注关系，已经关注过则什么都不写（ON CONFLICT DO NOTHING）
+// 返回是否真的新插入了一行：并发的两次关注只有一次返回 true
+func FollowTx(tx *gorm.DB, followerID, followeeID int64) (bool, error) {
 	fr := &domain.UserFollowRecord{
 		FollowerID: followerID,
 		FolloweeID: followeeID,
 	}
-	return DB.DB.WithContext(ctx).Create(fr).Error
+	res := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "follower_id"}, {Name: "followee_id"}},
+		DoNothing: true,
+	}).Create(fr)
+	return res.RowsAffected == 1, res.Error
 }
 
-// Unfollow 删除一条关注关系，删除 0 行也视为成功
-func Unfollow(ctx context.Context, followerID, followeeID int64) error {
-	return DB.DB.WithContext(ctx).
-		Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
-		Delete(&domain.UserFollowRecord{}).Error
+// UnfollowTx 删除一条关注关系，删除 0 行也视为成功；返回是否真的删掉了一行
+func UnfollowTx(tx *gorm.DB, followerID, followeeID int64) (bool, error) {
+	res := tx.Where("follower_id = ? AND followee_id = ?", followerID, followeeID).
+		Delete(&domain.UserFollowRecord{})
+	return res.RowsAffected == 1, res.Error
 }
 
 // IsFollowing 判断 follower 是否已经关注了 followee
@@ -37,6 +44,18 @@ func IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error
 }
 
 
+// ListFolloweeIDs 返回 follower 关注的所有人的 ID（不分页，用于拼 following feed）
+func ListFolloweeIDs(ctx context.Context, followerID int64) ([]int64, error) {
+	var ids []int64
+	if err := DB.DB.WithContext(ctx).
+		Model(&domain.UserFollowRecord{}).
+		Where("follower_id = ?", followerID).
+		Pluck("followee_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // BatchIsFollowing
 // viewerID 是否关注了 targets 中每个人（viewer -> target）
 func BatchIsFollowing(ctx context.Context, viewerID int64, targetIDs []int64) (map[int64]bool, error) {
@@ -161,4 +180,41 @@ func ListFollowees(
 		nextCursor = 0
 	}
 	return
-}
\ No newline at end of file
+}
+// CountFollowersBatch 统计每个 user 的粉丝数（followee_id = user），key = user_id，没有粉丝的不在 map 里
+func CountFollowersBatch(ctx context.Context, userIDs []int64) (map[int64]int, error) {
+	return countFollowsBatch(ctx, "followee_id", userIDs)
+}
+
+// CountFollowingBatch 统计每个 user 关注了多少人（follower_id = user）
+func CountFollowingBatch(ctx context.Context, userIDs []int64) (map[int64]int, error) {
+	return countFollowsBatch(ctx, "follower_id", userIDs)
+}
+
+func countFollowsBatch(ctx context.Context, column string, userIDs []int64) (map[int64]int, error) {
+	m := make(map[int64]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return m, nil
+	}
+
+	type result struct {
+		UserID int64
+		Count  int64
+	}
+
+	var rows []result
+	err := DB.DB.WithContext(ctx).
+		Model(&domain.UserFollowRecord{}).
+		Select(column+" AS user_id, COUNT(*) AS count").
+		Where(column+" IN ?", userIDs).
+		Group(column).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		m[r.UserID] = int(r.Count)
+	}
+	return m, nil
+}
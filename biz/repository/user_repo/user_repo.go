@@ -4,6 +4,9 @@ import (
 	"context"
 	"zetian-personal-website-hertz/biz/domain"
 	DB "zetian-personal-website-hertz/biz/repository"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 
@@ -16,6 +19,32 @@ func UpdateUser(ctx context.Context, user *domain.User) error {
 	return DB.DB.WithContext(ctx).Updates(user).Error
 }
 
+// UpdateUserSchoolID sets users.school_id (0 clears it).
+func UpdateUserSchoolID(ctx context.Context, userID, schoolID int64) error {
+	return DB.DB.WithContext(ctx).
+		Model(&domain.User{}).
+		Where("id = ?", userID).
+		Update("school_id", schoolID).Error
+}
+
+// UpdateUserShowLikedPosts sets users.show_liked_posts.
+func UpdateUserShowLikedPosts(ctx context.Context, userID int64, show bool) error {
+	return DB.DB.WithContext(ctx).
+		Model(&domain.User{}).
+		Where("id = ?", userID).
+		Update("show_liked_posts", show).Error
+}
+
+// LockUserTx locks the users row (SELECT ... FOR UPDATE) until tx ends, to serialize
+// per-user writes that check a limit first (e.g. max pinned posts).
+func LockUserTx(tx *gorm.DB, userID int64) error {
+	var user domain.User
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", userID).
+		Take(&user).Error
+}
+
 func GetUserByID(ctx context.Context, id int64) (*domain.User, error) {
     var user domain.User
     err := DB.DB.WithContext(ctx).Where("id = ?", id).First(&user).Error
@@ -52,6 +81,26 @@ func GetUserByUsername(ctx context.Context, username string) (*domain.User, erro
     return &user, nil
 }
 
+// GetUsersByUsernames returns users whose username is in names, key = username (exact match).
+func GetUsersByUsernames(ctx context.Context, names []string) (map[string]*domain.User, error) {
+	res := make(map[string]*domain.User)
+	if len(names) == 0 {
+		return res, nil
+	}
+
+	var users []*domain.User
+	if err := DB.DB.WithContext(ctx).Where("username IN ?", names).Find(&users).Error; err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if u == nil {
+			continue
+		}
+		res[u.Username] = u
+	}
+	return res, nil
+}
+
 func GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var user domain.User
 	err := DB.DB.WithContext(ctx).Where("email = ?", email).First(&user).Error
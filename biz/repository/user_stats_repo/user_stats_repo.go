@@ -47,27 +47,52 @@ func GetStatsBatch(ctx context.Context, userIDs []int64) (map[int64]*domain.User
     return statsMap, nil
 }
 
-// （预留：以后可以加 IncrementFollowers / IncrementFollowing / IncrementPostLikeReceived 等）
-func IncrementFollowers(ctx context.Context, userID int64, delta int64) error {
-    return DB.DB.WithContext(ctx).
-        Model(&domain.UserStats{}).
-        Where("user_id = ?", userID).
-        Update("followers_count", gorm.Expr("followers_count + ?", delta)).
-        Error
+// ListStatsAfterUserID 按 user_id 升序返回 user_id > afterUserID 的统计行（给对账等批处理遍历全表用）
+func ListStatsAfterUserID(ctx context.Context, afterUserID int64, limit int) ([]domain.UserStats, error) {
+    var list []domain.UserStats
+    err := DB.DB.WithContext(ctx).
+        Where("user_id > ?", afterUserID).
+        Order("user_id ASC").
+        Limit(limit).
+        Find(&list).Error
+    return list, err
 }
 
-func IncrementFollowing(ctx context.Context, userID int64, delta int64) error {
-    return DB.DB.WithContext(ctx).
-        Model(&domain.UserStats{}).
+// 计数的 +/- 都是 col = col + delta，并发安全；*Tx 版本和关系表的写入放在同一个事务里
+func IncrementFollowersTx(tx *gorm.DB, userID int64, delta int64) error {
+    return incrementColumnTx(tx, userID, "followers_count", delta)
+}
+
+func IncrementFollowingTx(tx *gorm.DB, userID int64, delta int64) error {
+    return incrementColumnTx(tx, userID, "following_count", delta)
+}
+
+func IncrementPostLikeReceived(ctx context.Context, userID int64, delta int64) error {
+    return IncrementPostLikeReceivedTx(DB.DB.WithContext(ctx), userID, delta)
+}
+
+func IncrementPostLikeReceivedTx(tx *gorm.DB, userID int64, delta int64) error {
+    return incrementColumnTx(tx, userID, "post_like_received_count", delta)
+}
+
+func incrementColumnTx(tx *gorm.DB, userID int64, column string, delta int64) error {
+    return tx.Model(&domain.UserStats{}).
         Where("user_id = ?", userID).
-        Update("following_count", gorm.Expr("following_count + ?", delta)).
+        Update(column, gorm.Expr(column+" + ?", delta)).
         Error
 }
 
-func IncrementPostLikeReceived(ctx context.Context, userID int64, delta int64) error {
+// RecountStats 用关系表重新计算某个用户的 followers / following / 收到的赞，
+// 在 UPDATE 里直接 COUNT，避免和并发的关注 / 点赞互相覆盖
+func RecountStats(ctx context.Context, userID int64) error {
     return DB.DB.WithContext(ctx).
         Model(&domain.UserStats{}).
         Where("user_id = ?", userID).
-        Update("post_like_received_count", gorm.Expr("post_like_received_count + ?", delta)).
-        Error
+        Updates(map[string]any{
+            "followers_count": gorm.Expr("(SELECT COUNT(*) FROM user_follow_records WHERE followee_id = user_stats.user_id)"),
+            "following_count": gorm.Expr("(SELECT COUNT(*) FROM user_follow_records WHERE follower_id = user_stats.user_id)"),
+            "post_like_received_count": gorm.Expr(
+                "(SELECT COUNT(*) FROM post_likes JOIN post_bases ON post_bases.id = post_likes.post_id WHERE post_bases.user_id = user_stats.user_id)",
+            ),
+        }).Error
 }
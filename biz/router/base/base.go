@@ -26,23 +26,74 @@ func Register(r *server.Hertz) {
 		_category := root.Group("/category", _categoryMw()...)
 		_category.GET("/all", append(_getallcategoriesMw(), base.GetAllCategories)...)
 	}
+	{
+		_comment := root.Group("/comment", _commentMw()...)
+		_comment.POST("/create", append(_createcommentMw(), base.CreateComment)...)
+		_comment.POST("/delete", append(_deletecommentMw(), base.DeleteComment)...)
+		_comment.POST("/like", append(_likecommentMw(), base.LikeComment)...)
+		_comment.GET("/list", append(_getpostcommentsMw(), base.GetPostComments)...)
+		_comment.GET("/replies", append(_getcommentrepliesMw(), base.GetCommentReplies)...)
+		_comment.POST("/unlike", append(_unlikecommentMw(), base.UnlikeComment)...)
+	}
 	{
 		_post := root.Group("/post", _postMw()...)
+		_post.POST("/batch_get", append(_batchgetpostsMw(), base.BatchGetPosts)...)
 		_post.POST("/create", append(_createpostMw(), base.CreatePost)...)
 		_post.POST("/delete", append(_deletepostMw(), base.DeletePost)...)
+		_post.GET("/drafts", append(_getmydraftsMw(), base.GetMyDrafts)...)
 		_post.POST("/edit", append(_editpostMw(), base.EditPost)...)
 		_post.POST("/fav", append(_favpostMw(), base.FavPost)...)
+		_post.GET("/favorites", append(_getfavoritedpostsMw(), base.GetFavoritedPosts)...)
 		_post.GET("/get", append(_getpostbyidMw(), base.GetPostByID)...)
+		_post.GET("/hot", append(_gethotpostsMw(), base.GetHotPosts)...)
 		_post.POST("/like", append(_likepostMw(), base.LikePost)...)
+		_post.GET("/liked", append(_getlikedpostsMw(), base.GetLikedPosts)...)
+		_post.GET("/likers", append(_getpostlikersMw(), base.GetPostLikers)...)
+		_post.GET("/mentions", append(_getmentionedpostsMw(), base.GetMentionedPosts)...)
+		_post.GET("/nearby", append(_getnearbypostsMw(), base.GetNearbyPosts)...)
 		_post.GET("/personal", append(_getpersonalrecentpostsMw(), base.GetPersonalRecentPosts)...)
+		_post.POST("/pin", append(_pinpostMw(), base.PinPost)...)
+		_post.GET("/quotes", append(_getpostquotesMw(), base.GetPostQuotes)...)
+		_post.POST("/report", append(_reportpostMw(), base.ReportPost)...)
+		_post.POST("/restore", append(_restorepostMw(), base.RestorePost)...)
+		_post.GET("/revisions", append(_getpostrevisionsMw(), base.GetPostRevisions)...)
+		_post.GET("/search", append(_searchpostsMw(), base.SearchPosts)...)
+		_post.GET("/trash", append(_gettrashedpostsMw(), base.GetTrashedPosts)...)
 		_post.POST("/unfav", append(_unfavpostMw(), base.UnfavPost)...)
 		_post.POST("/unlike", append(_unlikepostMw(), base.UnlikePost)...)
+		_post.POST("/unpin", append(_unpinpostMw(), base.UnpinPost)...)
+		{
+			_category0 := _post.Group("/category", _category0Mw()...)
+			_category0.GET("/recent", append(_getcategoryrecentpostsMw(), base.GetCategoryRecentPosts)...)
+		}
+		{
+			_draft := _post.Group("/draft", _draftMw()...)
+			_draft.POST("/publish", append(_publishdraftMw(), base.PublishDraft)...)
+			_draft.POST("/save", append(_savedraftMw(), base.SaveDraft)...)
+			_draft.POST("/update", append(_updatedraftMw(), base.UpdateDraft)...)
+		}
+		{
+			_following := _post.Group("/following", _followingMw()...)
+			_following.GET("/recent", append(_getfollowingusersrecentpostsMw(), base.GetFollowingUsersRecentPosts)...)
+		}
 		{
 			_media := _post.Group("/media", _mediaMw()...)
 			_media.POST("/upload", append(_uploadpostmediaMw(), base.UploadPostMedia)...)
 		}
+		{
+			_moderation := _post.Group("/moderation", _moderationMw()...)
+			_moderation.GET("/actions", append(_getmoderationactionsMw(), base.GetModerationActions)...)
+			_moderation.GET("/mine", append(_getmymoderationactionsMw(), base.GetMyModerationActions)...)
+			_moderation.GET("/reports", append(_getpendingreportsMw(), base.GetPendingReports)...)
+			_moderation.POST("/resolve", append(_resolvepostreportsMw(), base.ResolvePostReports)...)
+		}
+		{
+			_poll := _post.Group("/poll", _pollMw()...)
+			_poll.POST("/vote", append(_votepollMw(), base.VotePoll)...)
+		}
 		{
 			_school := _post.Group("/school", _schoolMw()...)
+			_school.GET("/hot", append(_getschoolhotpostsMw(), base.GetSchoolHotPosts)...)
 			_school.GET("/recent", append(_getschoolrecentpostsMw(), base.GetSchoolRecentPosts)...)
 		}
 	}
@@ -50,6 +101,11 @@ func Register(r *server.Hertz) {
 		_school0 := root.Group("/school", _school0Mw()...)
 		_school0.GET("/all", append(_getallschoolsMw(), base.GetAllSchools)...)
 	}
+	{
+		_tag := root.Group("/tag", _tagMw()...)
+		_tag.GET("/posts", append(_gettagpostsMw(), base.GetTagPosts)...)
+		_tag.GET("/trending", append(_gettrendingtagsMw(), base.GetTrendingTags)...)
+	}
 	{
 		_user := root.Group("/user", _userMw()...)
 		_user.POST("/follow", append(_followuserMw(), base.FollowUser)...)
@@ -60,6 +116,8 @@ func Register(r *server.Hertz) {
 		_user.POST("/reset-password", append(_resetpasswordMw(), base.ResetPassword)...)
 		_user.POST("/unfollow", append(_unfollowuserMw(), base.UnfollowUser)...)
 		_user.POST("/update-avatar", append(_updateavatarMw(), base.UpdateAvatar)...)
+		_user.POST("/update-privacy", append(_updateprivacyMw(), base.UpdatePrivacy)...)
+		_user.POST("/update-school", append(_updateschoolMw(), base.UpdateSchool)...)
 	}
 	{
 		_verification := root.Group("/verification", _verificationMw()...)
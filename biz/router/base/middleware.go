@@ -3,6 +3,8 @@
 package base
 
 import (
+	"zetian-personal-website-hertz/biz/middleware"
+
 	"github.com/cloudwego/hertz/pkg/app"
 )
 
@@ -12,8 +14,7 @@ func rootMw() []app.HandlerFunc {
 }
 
 func _loginMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{middleware.RateLimit("/login")}
 }
 
 func _signupMw() []app.HandlerFunc {
@@ -37,8 +38,7 @@ func _emailMw() []app.HandlerFunc {
 }
 
 func _sendvericodetoemailMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{middleware.RateLimit("/verification/email/send-code")}
 }
 
 func _verifyemailcodeMw() []app.HandlerFunc {
@@ -57,8 +57,7 @@ func _postMw() []app.HandlerFunc {
 }
 
 func _createpostMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{middleware.RateLimit("/post/create")}
 }
 
 func _deletepostMw() []app.HandlerFunc {
@@ -107,8 +106,7 @@ func _unfavpostMw() []app.HandlerFunc {
 }
 
 func _likepostMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	return []app.HandlerFunc{middleware.RateLimit("/post/like")}
 }
 
 func _unlikepostMw() []app.HandlerFunc {
@@ -195,3 +193,223 @@ func _getfollowersMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _followingMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getfollowingusersrecentpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _gethotpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getschoolhotpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _commentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createcommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletecommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _likecommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getpostcommentsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcommentrepliesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unlikecommentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _tagMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _gettagpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _gettrendingtagsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _category0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcategoryrecentpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getpostrevisionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _restorepostMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _gettrashedpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmydraftsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _draftMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _publishdraftMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _savedraftMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatedraftMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateschoolMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _pollMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _votepollMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getnearbypostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmentionedpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getpostquotesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getfavoritedpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getlikedpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateprivacyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getpostlikersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _batchgetpostsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reportpostMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _moderationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmoderationactionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getpendingreportsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resolvepostreportsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _pinpostMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _unpinpostMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getmymoderationactionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -0,0 +1,466 @@
+package comment_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_comment_like_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_comment_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_stats_repo"
+	"zetian-personal-website-hertz/biz/repository/user_repo"
+	"zetian-personal-website-hertz/biz/service/hot_score_service"
+	"zetian-personal-website-hertz/biz/service/visibility_service"
+
+	"gorm.io/gorm"
+)
+
+const (
+	maxCommentLength = 2000 // in runes
+
+	defaultCommentListLimit = 20
+	maxCommentListLimit     = 50
+)
+
+// ErrNoPermission is returned when the user is neither the comment author nor the post author.
+var ErrNoPermission = errors.New("no permission to delete this comment")
+
+type CommentListResult struct {
+	Comments   []domain.Comment
+	NextCursor int64
+	HasMore    bool
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Create / Delete
+///////////////////////////////////////////////////////////////////////////////
+
+// CreateComment creates a comment on a post.
+//
+// In one transaction it will:
+//  1. Insert into post_comments
+//  2. Increment post_stats.comment_count and set last_comment_at
+//  3. If it is a reply, increment reply_count of the top-level comment
+//
+// parentID <= 0 means a top-level comment. A reply to a reply stays in the same
+// thread (root_id of the parent), so threads are never deeper than two levels.
+func CreateComment(
+	ctx context.Context,
+	userID int64,
+	postID int64,
+	content string,
+	parentID int64,
+) (*domain.Comment, error) {
+
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return nil, fmt.Errorf("content cannot be empty")
+	}
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return nil, fmt.Errorf("content cannot be longer than %d characters", maxCommentLength)
+	}
+
+	// Ensure post exists and the commenter can see it
+	if err := checkPostReadable(ctx, postID, userID); err != nil {
+		return nil, fmt.Errorf("post not found: %w", err)
+	}
+
+	comment := &domain.PostComment{
+		PostID:  postID,
+		UserID:  userID,
+		Content: content,
+	}
+
+	replyToUserName := ""
+	if parentID > 0 {
+		parent, err := post_comment_repo.GetCommentByID(ctx, parentID)
+		if err != nil {
+			return nil, fmt.Errorf("parent comment not found: %w", err)
+		}
+		if parent.PostID != postID {
+			return nil, fmt.Errorf("parent comment does not belong to this post")
+		}
+
+		comment.ParentID = parent.ID
+		comment.ReplyToUserID = parent.UserID
+		if parent.RootID > 0 {
+			comment.RootID = parent.RootID
+		} else {
+			comment.RootID = parent.ID
+		}
+
+		if u, err := user_repo.GetUserByID(ctx, parent.UserID); err == nil && u != nil {
+			replyToUserName = u.Username
+		}
+	}
+
+	// 计数没有对账任务兜底，所以和评论行一起提交
+	err := DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1) Create row
+		if err := post_comment_repo.CreateCommentTx(tx, comment); err != nil {
+			return fmt.Errorf("failed to create comment: %w", err)
+		}
+
+		// 2) post_stats
+		if err := post_stats_repo.IncrementCommentTx(tx, postID, 1); err != nil {
+			return fmt.Errorf("failed to update comment count: %w", err)
+		}
+		if err := post_stats_repo.SetLastCommentAtTx(tx, postID, comment.CreatedAt.Unix()); err != nil {
+			return fmt.Errorf("failed to update last comment time: %w", err)
+		}
+
+		// 3) reply_count of thread root
+		if comment.RootID > 0 {
+			if err := post_comment_repo.IncrementReplyCountTx(tx, comment.RootID, 1); err != nil {
+				return fmt.Errorf("failed to update reply count: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	hot_score_service.Touch(postID)
+
+	userName, avatarURL := "", ""
+	if u, err := user_repo.GetUserByID(ctx, userID); err == nil && u != nil {
+		userName = u.Username
+		avatarURL = u.AvatarUrl
+	}
+
+	return &domain.Comment{
+		PostComment:     *comment,
+		UserName:        userName,
+		UserAvatarUrl:   avatarURL,
+		ReplyToUserName: replyToUserName,
+		IsLikedByUser:   false,
+	}, nil
+}
+
+// DeleteComment deletes a comment.
+// Allowed for the comment author and the author of the post.
+//
+// Notes:
+//   - Deleting a top-level comment deletes the whole thread (all replies).
+//   - comment_count is decreased by the number of deleted rows and
+//     last_comment_at is recomputed from the remaining comments,
+//     in the same transaction as the delete.
+func DeleteComment(ctx context.Context, userID, commentID int64) error {
+	comment, err := post_comment_repo.GetCommentByID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+
+	if comment.UserID != userID {
+		base, err := post_base_repo.GetPostBaseByID(ctx, comment.PostID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to load post: %w", err)
+		}
+		if base == nil || base.UserID != userID {
+			return ErrNoPermission
+		}
+	}
+
+	// 1) collect rows to be deleted (the comment, plus its replies if top-level)
+	ids := []int64{comment.ID}
+	if comment.RootID == 0 {
+		ids, err = post_comment_repo.ListThreadCommentIDs(ctx, comment.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list replies: %w", err)
+		}
+	}
+
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 2) delete likes first, then comments
+		if err := post_comment_like_repo.DeleteLikesByCommentIDsTx(tx, ids); err != nil {
+			return fmt.Errorf("delete comment likes: %w", err)
+		}
+		if err := post_comment_repo.DeleteCommentsByIDsTx(tx, ids); err != nil {
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		// 3) counters
+		if err := post_stats_repo.IncrementCommentTx(tx, comment.PostID, -int32(len(ids))); err != nil {
+			return fmt.Errorf("failed to update comment count: %w", err)
+		}
+		if comment.RootID > 0 {
+			if err := post_comment_repo.IncrementReplyCountTx(tx, comment.RootID, -1); err != nil {
+				return fmt.Errorf("failed to update reply count: %w", err)
+			}
+		}
+		if err := refreshLastCommentAtTx(tx, comment.PostID); err != nil {
+			return fmt.Errorf("failed to update last comment time: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	hot_score_service.Touch(comment.PostID)
+
+	return nil
+}
+
+// refreshLastCommentAtTx recomputes post_stats.last_comment_at from remaining comments.
+func refreshLastCommentAtTx(tx *gorm.DB, postID int64) error {
+	latest, ok, err := post_comment_repo.GetLatestCommentTimeTx(tx, postID)
+	if err != nil {
+		return err
+	}
+	ts := int64(0)
+	if ok {
+		ts = latest.Unix()
+	}
+	return post_stats_repo.SetLastCommentAtTx(tx, postID, ts)
+}
+
+// DeleteCommentsByPostID removes every comment (and comment like) of a post.
+// Used when the post itself is deleted.
+func DeleteCommentsByPostID(ctx context.Context, postID int64) error {
+	ids, err := post_comment_repo.ListCommentIDsByPostID(ctx, postID)
+	if err != nil {
+		return fmt.Errorf("list comments: %w", err)
+	}
+	if err := post_comment_like_repo.DeleteLikesByCommentIDs(ctx, ids); err != nil {
+		return fmt.Errorf("delete comment likes: %w", err)
+	}
+	return post_comment_repo.DeleteCommentsByPostID(ctx, postID)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// List
+///////////////////////////////////////////////////////////////////////////////
+
+// GetPostComments lists top-level comments of a post, oldest first.
+func GetPostComments(
+	ctx context.Context,
+	viewerID int64,
+	postID int64,
+	cursor int64,
+	limit int32,
+) (*CommentListResult, error) {
+	if postID <= 0 {
+		return nil, errors.New("invalid post id")
+	}
+	limit = normalizeLimit(limit)
+
+	if err := checkPostReadable(ctx, postID, viewerID); err != nil {
+		return nil, err
+	}
+
+	records, nextCursor, hasMore, err :=
+		post_comment_repo.ListTopLevelComments(ctx, postID, cursor, int(limit))
+	if err != nil {
+		return nil, err
+	}
+	return buildCommentList(ctx, records, viewerID, nextCursor, hasMore)
+}
+
+// GetCommentReplies lists replies of a top-level comment, oldest first.
+func GetCommentReplies(
+	ctx context.Context,
+	viewerID int64,
+	rootID int64,
+	cursor int64,
+	limit int32,
+) (*CommentListResult, error) {
+	if rootID <= 0 {
+		return nil, errors.New("invalid comment id")
+	}
+	limit = normalizeLimit(limit)
+
+	root, err := post_comment_repo.GetCommentByID(ctx, rootID)
+	if err != nil {
+		return nil, fmt.Errorf("comment not found: %w", err)
+	}
+	if err := checkPostReadable(ctx, root.PostID, viewerID); err != nil {
+		return nil, err
+	}
+
+	records, nextCursor, hasMore, err :=
+		post_comment_repo.ListReplies(ctx, rootID, cursor, int(limit))
+	if err != nil {
+		return nil, err
+	}
+	return buildCommentList(ctx, records, viewerID, nextCursor, hasMore)
+}
+
+// checkPostReadable applies GetPost's gate (status / hidden / visibility) to the post of a comment list,
+// so comments of drafts, restricted or hidden posts are not readable through the comment APIs.
+// Trashed posts are not found by GetPostBaseByID. Returns gorm.ErrRecordNotFound (wrapped) when denied.
+func checkPostReadable(ctx context.Context, postID, viewerID int64) error {
+	base, err := post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
+		return fmt.Errorf("failed to load post: %w", err)
+	}
+	return visibility_service.CheckPostReadable(ctx, base, viewerID)
+}
+
+func normalizeLimit(limit int32) int32 {
+	if limit <= 0 {
+		limit = defaultCommentListLimit
+	}
+	if limit > maxCommentListLimit {
+		limit = maxCommentListLimit
+	}
+	return limit
+}
+
+// buildCommentList fills author / reply-to names and viewer's like flags (batch queries).
+func buildCommentList(
+	ctx context.Context,
+	records []domain.PostComment,
+	viewerID int64,
+	nextCursor int64,
+	hasMore bool,
+) (*CommentListResult, error) {
+	if len(records) == 0 {
+		return &CommentListResult{
+			Comments:   []domain.Comment{},
+			NextCursor: 0,
+			HasMore:    false,
+		}, nil
+	}
+
+	commentIDs := make([]int64, 0, len(records))
+	userIDs := make([]int64, 0, len(records)*2)
+	userIDSet := make(map[int64]struct{})
+	addUser := func(id int64) {
+		if id <= 0 {
+			return
+		}
+		if _, ok := userIDSet[id]; !ok {
+			userIDSet[id] = struct{}{}
+			userIDs = append(userIDs, id)
+		}
+	}
+	for _, r := range records {
+		commentIDs = append(commentIDs, r.ID)
+		addUser(r.UserID)
+		addUser(r.ReplyToUserID)
+	}
+
+	var (
+		userMap  map[int64]*domain.User
+		likedSet map[int64]bool
+	)
+
+	var wg sync.WaitGroup
+	errChan := make(chan error, 2)
+
+	// 1) users in batch
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		m, err := user_repo.GetUsersByIDs(ctx, userIDs)
+		if err != nil {
+			errChan <- fmt.Errorf("get users by ids: %w", err)
+			return
+		}
+		userMap = m
+	}()
+
+	// 2) viewer liked set
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if viewerID <= 0 {
+			likedSet = map[int64]bool{}
+			return
+		}
+		m, err := post_comment_like_repo.GetUserLikedCommentIDs(ctx, viewerID, commentIDs)
+		if err != nil {
+			errChan <- fmt.Errorf("get user liked comments: %w", err)
+			return
+		}
+		likedSet = m
+	}()
+
+	wg.Wait()
+	close(errChan)
+	for err := range errChan {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	comments := make([]domain.Comment, 0, len(records))
+	for _, r := range records {
+		c := domain.Comment{
+			PostComment:   r,
+			IsLikedByUser: likedSet[r.ID],
+		}
+		if u, ok := userMap[r.UserID]; ok && u != nil {
+			c.UserName = u.Username
+			c.UserAvatarUrl = u.AvatarUrl
+		}
+		if u, ok := userMap[r.ReplyToUserID]; ok && u != nil {
+			c.ReplyToUserName = u.Username
+		}
+		comments = append(comments, c)
+	}
+
+	return &CommentListResult{
+		Comments:   comments,
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
+	}, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Like / Unlike
+///////////////////////////////////////////////////////////////////////////////
+
+// LikeComment lets a user like a comment.
+// - Idempotent: if already liked, it's a no-op.
+func LikeComment(ctx context.Context, userID, commentID int64) error {
+	if _, err := post_comment_repo.GetCommentByID(ctx, commentID); err != nil {
+		return fmt.Errorf("comment not found: %w", err)
+	}
+
+	liked, err := post_comment_like_repo.HasUserLiked(ctx, userID, commentID)
+	if err != nil {
+		return fmt.Errorf("failed to check like state: %w", err)
+	}
+	if liked {
+		return nil
+	}
+
+	if err := post_comment_like_repo.LikeComment(ctx, userID, commentID); err != nil {
+		return fmt.Errorf("failed to like comment: %w", err)
+	}
+
+	// Increment like_count (best-effort)
+	_ = post_comment_repo.IncrementLikeCount(ctx, commentID, 1)
+	return nil
+}
+
+// UnlikeComment lets a user remove like from a comment.
+// - If not liked, it's treated as success.
+func UnlikeComment(ctx context.Context, userID, commentID int64) error {
+	if _, err := post_comment_repo.GetCommentByID(ctx, commentID); err != nil {
+		return fmt.Errorf("comment not found: %w", err)
+	}
+
+	liked, err := post_comment_like_repo.HasUserLiked(ctx, userID, commentID)
+	if err != nil {
+		return fmt.Errorf("failed to check like state: %w", err)
+	}
+	if !liked {
+		return nil
+	}
+
+	if err := post_comment_like_repo.UnlikeComment(ctx, userID, commentID); err != nil {
+		return fmt.Errorf("failed to unlike comment: %w", err)
+	}
+
+	// Decrement like_count (best-effort)
+	_ = post_comment_repo.IncrementLikeCount(ctx, commentID, -1)
+	return nil
+}
@@ -0,0 +1,233 @@
+package hot_score_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_stats_repo"
+
+	"gorm.io/gorm"
+)
+
+/*
+Hot score
+---------
+hot_score = engagement / (ageHours + 2) ^ gravity * scoreScale
+
+engagement is a weighted sum of the counters in post_stats. The denominator makes
+a post cool down over time, so a fresh post with a few likes can beat an old post
+with many likes.
+
+The score is stored as int64 (post_stats.hot_score), that is why it is scaled.
+
+When is it recomputed?
+  - Touch(postID) is called whenever a counter of the post changes. Touched posts are
+    collected in memory and recomputed by the scheduler every dirtyFlushInterval,
+    so a burst of likes on the same post only costs one UPDATE.
+  - Every fullRecomputeInterval, all posts created within scoreWindow are recomputed,
+    because time decay changes the score even if nobody touches the post.
+  - Posts older than scoreWindow get hot_score = 0.
+*/
+
+const (
+	likeWeight    = 1.0
+	favWeight     = 2.0
+	viewWeight    = 0.1
+	commentWeight = 3.0
+	shareWeight   = 4.0
+
+	ageOffsetHours = 2.0
+	gravity        = 1.5
+	scoreScale     = 10000.0
+
+	scoreWindow           = 7 * 24 * time.Hour
+	dirtyFlushInterval    = 30 * time.Second
+	fullRecomputeInterval = 10 * time.Minute
+
+	statsBatchSize = 500
+)
+
+// ComputeHotScore computes hot score of a post at time now.
+func ComputeHotScore(stats domain.PostStats, createdAt time.Time, now time.Time) int64 {
+	engagement := likeWeight*float64(stats.LikeCount) +
+		favWeight*float64(stats.FavCount) +
+		viewWeight*float64(stats.ViewCount) +
+		commentWeight*float64(stats.CommentCount) +
+		shareWeight*float64(stats.ShareCount)
+	if engagement <= 0 {
+		return 0
+	}
+
+	ageHours := now.Sub(createdAt).Hours()
+	if ageHours < 0 {
+		ageHours = 0
+	}
+
+	score := engagement / math.Pow(ageHours+ageOffsetHours, gravity) * scoreScale
+	return int64(math.Round(score))
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Recompute
+///////////////////////////////////////////////////////////////////////////////
+
+// RecomputePost recomputes and stores hot_score of one post.
+// A deleted post (or missing stats row) is silently ignored.
+func RecomputePost(ctx context.Context, postID int64) error {
+	base, err := post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("load post %d: %w", postID, err)
+	}
+
+	stats, err := post_stats_repo.GetStats(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("load post stats %d: %w", postID, err)
+	}
+
+	score := int64(0)
+	now := time.Now()
+	if now.Sub(base.CreatedAt) <= scoreWindow {
+		score = ComputeHotScore(*stats, base.CreatedAt, now)
+	}
+	if score == stats.HotScore {
+		return nil
+	}
+	return post_stats_repo.UpdateHotScore(ctx, postID, score)
+}
+
+// RecomputeRecentPosts recomputes hot_score of every post inside scoreWindow,
+// and resets hot_score of older posts to 0.
+func RecomputeRecentPosts(ctx context.Context) error {
+	now := time.Now()
+	cutoff := now.Add(-scoreWindow)
+
+	bases, err := post_base_repo.ListPostBasesCreatedAfter(ctx, cutoff)
+	if err != nil {
+		return fmt.Errorf("list recent posts: %w", err)
+	}
+
+	for start := 0; start < len(bases); start += statsBatchSize {
+		end := start + statsBatchSize
+		if end > len(bases) {
+			end = len(bases)
+		}
+		chunk := bases[start:end]
+
+		postIDs := make([]int64, 0, len(chunk))
+		for _, b := range chunk {
+			postIDs = append(postIDs, b.ID)
+		}
+		statsMap, err := post_stats_repo.GetStatsBatch(ctx, postIDs)
+		if err != nil {
+			return fmt.Errorf("get stats batch: %w", err)
+		}
+
+		for _, b := range chunk {
+			s := statsMap[b.ID]
+			if s == nil {
+				continue
+			}
+			score := ComputeHotScore(*s, b.CreatedAt, now)
+			if score == s.HotScore {
+				continue
+			}
+			if err := post_stats_repo.UpdateHotScore(ctx, b.ID, score); err != nil {
+				return fmt.Errorf("update hot score %d: %w", b.ID, err)
+			}
+		}
+	}
+
+	if err := post_stats_repo.ResetHotScoreForPostsBefore(ctx, cutoff); err != nil {
+		return fmt.Errorf("reset old hot scores: %w", err)
+	}
+	return nil
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Dirty set + scheduler
+///////////////////////////////////////////////////////////////////////////////
+
+var (
+	dirtyMu      sync.Mutex
+	dirtyPostIDs = make(map[int64]struct{})
+
+	startOnce sync.Once
+)
+
+// Touch marks a post whose counters changed; its hot_score will be recomputed
+// by the scheduler shortly. Safe to call from any goroutine, never blocks on DB.
+func Touch(postID int64) {
+	if postID <= 0 {
+		return
+	}
+	dirtyMu.Lock()
+	dirtyPostIDs[postID] = struct{}{}
+	dirtyMu.Unlock()
+}
+
+// takeDirty swaps out the current dirty set.
+func takeDirty() []int64 {
+	dirtyMu.Lock()
+	defer dirtyMu.Unlock()
+
+	if len(dirtyPostIDs) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(dirtyPostIDs))
+	for id := range dirtyPostIDs {
+		ids = append(ids, id)
+	}
+	dirtyPostIDs = make(map[int64]struct{})
+	return ids
+}
+
+func flushDirty(ctx context.Context) {
+	for _, id := range takeDirty() {
+		if err := RecomputePost(ctx, id); err != nil {
+			log.Printf("hot score: recompute post %d failed: %v", id, err)
+		}
+	}
+}
+
+// StartHotScoreScheduler starts the background goroutine that keeps hot_score up to date.
+// Calling it more than once has no effect.
+func StartHotScoreScheduler() {
+	startOnce.Do(func() {
+		go func() {
+			ctx := context.Background()
+
+			if err := RecomputeRecentPosts(ctx); err != nil {
+				log.Printf("hot score: full recompute failed: %v", err)
+			}
+
+			dirtyTicker := time.NewTicker(dirtyFlushInterval)
+			fullTicker := time.NewTicker(fullRecomputeInterval)
+			defer dirtyTicker.Stop()
+			defer fullTicker.Stop()
+
+			for {
+				select {
+				case <-dirtyTicker.C:
+					flushDirty(ctx)
+				case <-fullTicker.C:
+					if err := RecomputeRecentPosts(ctx); err != nil {
+						log.Printf("hot score: full recompute failed: %v", err)
+					}
+				}
+			}
+		}()
+	})
+}
@@ -0,0 +1,41 @@
+package hot_score_service
+
+import (
+	"testing"
+	"time"
+	"zetian-personal-website-hertz/biz/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeHotScoreNoEngagement(t *testing.T) {
+	now := time.Now()
+	score := ComputeHotScore(domain.PostStats{}, now, now)
+	assert.Equal(t, int64(0), score, "post without any engagement should have zero score")
+}
+
+func TestComputeHotScoreMoreEngagementIsHotter(t *testing.T) {
+	now := time.Now()
+	createdAt := now.Add(-3 * time.Hour)
+
+	low := ComputeHotScore(domain.PostStats{LikeCount: 3}, createdAt, now)
+	high := ComputeHotScore(domain.PostStats{LikeCount: 3, FavCount: 1, CommentCount: 2}, createdAt, now)
+	assert.Greater(t, high, low)
+}
+
+func TestComputeHotScoreDecaysWithAge(t *testing.T) {
+	now := time.Now()
+	stats := domain.PostStats{LikeCount: 10, ViewCount: 100}
+
+	fresh := ComputeHotScore(stats, now.Add(-1*time.Hour), now)
+	old := ComputeHotScore(stats, now.Add(-48*time.Hour), now)
+	assert.Greater(t, fresh, old, "same engagement should score lower when the post is older")
+}
+
+func TestComputeHotScoreFreshBeatsOldPopular(t *testing.T) {
+	now := time.Now()
+
+	freshPost := ComputeHotScore(domain.PostStats{LikeCount: 5}, now.Add(-30*time.Minute), now)
+	oldPost := ComputeHotScore(domain.PostStats{LikeCount: 50}, now.Add(-5*24*time.Hour), now)
+	assert.Greater(t, freshPost, oldPost)
+}
@@ -0,0 +1,148 @@
+package mention_service
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_mention_repo"
+	"zetian-personal-website-hertz/biz/repository/user_repo"
+
+	"gorm.io/gorm"
+)
+
+/*
+@mentions
+---------
+"@username" in a post's Content links to that user:
+  - the name is a run of letters / digits / '_' / '.' / '-' right after '@' (or fullwidth '＠'),
+    trailing '.' and '-' are dropped ("thanks @bob." mentions bob);
+  - '@' right after an ASCII letter / digit / '_' / '.' / '-' is not a mention, so e-mails
+    are ignored while "你好@小明" still works;
+  - names are matched exactly against users.username, unknown names are plain text.
+
+Resolved mentions are stored in post_mentions (with rune offsets for clients to link),
+which also backs the "posts that mention me" feed.
+*/
+
+const (
+	MaxUsernameLength  = 64
+	MaxMentionsPerPost = 20 // distinct users
+)
+
+// Token is an "@name" found in content. Start / End are rune offsets, '@' included, [Start, End).
+type Token struct {
+	Username string
+	Start    int
+	End      int
+}
+
+// ParseMentions returns every @name token of content, in order.
+func ParseMentions(content string) []Token {
+	runes := []rune(content)
+	var tokens []Token
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '@' && runes[i] != '＠' {
+			continue
+		}
+		if i > 0 && isEmailRune(runes[i-1]) {
+			continue
+		}
+
+		j := i + 1
+		for j < len(runes) && isNameRune(runes[j]) {
+			j++
+		}
+		end := j
+		for end > i+1 && (runes[end-1] == '.' || runes[end-1] == '-') {
+			end--
+		}
+
+		name := string(runes[i+1 : end])
+		if name != "" && end-i-1 <= MaxUsernameLength {
+			tokens = append(tokens, Token{Username: name, Start: i, End: end})
+		}
+		i = j - 1
+	}
+	return tokens
+}
+
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
+
+func isEmailRune(r rune) bool {
+	return r < unicode.MaxASCII && isNameRune(r)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// post_mentions maintenance
+///////////////////////////////////////////////////////////////////////////////
+
+// SyncPostMentions sets post_mentions of a post to the mentions in content and returns them.
+// Called when a post is published (drafts) and when its content is edited.
+func SyncPostMentions(ctx context.Context, postID int64, content string) ([]domain.PostMention, error) {
+	return SyncPostMentionsTx(DB.DB.WithContext(ctx), postID, content)
+}
+
+// SyncPostMentionsTx is SyncPostMentions writing inside tx (CreatePost writes the post and its mentions together).
+func SyncPostMentionsTx(tx *gorm.DB, postID int64, content string) ([]domain.PostMention, error) {
+	ctx := tx.Statement.Context
+	tokens := ParseMentions(content)
+
+	names := make([]string, 0, len(tokens))
+	seen := make(map[string]struct{}, len(tokens))
+	for _, t := range tokens {
+		if _, ok := seen[t.Username]; ok {
+			continue
+		}
+		seen[t.Username] = struct{}{}
+		names = append(names, t.Username)
+	}
+
+	users, err := user_repo.GetUsersByUsernames(ctx, names)
+	if err != nil {
+		return nil, fmt.Errorf("resolve mentioned users: %w", err)
+	}
+
+	rows := make([]domain.PostMention, 0, len(tokens))
+	mentioned := make(map[int64]struct{})
+	for _, t := range tokens {
+		u, ok := users[t.Username]
+		if !ok {
+			continue
+		}
+		userID := int64(u.ID)
+		if _, ok := mentioned[userID]; !ok {
+			if len(mentioned) >= MaxMentionsPerPost {
+				continue
+			}
+			mentioned[userID] = struct{}{}
+		}
+		rows = append(rows, domain.PostMention{
+			PostID:   postID,
+			UserID:   userID,
+			Username: u.Username,
+			Start:    int32(t.Start),
+			End:      int32(t.End),
+		})
+	}
+
+	if err := post_mention_repo.ReplacePostMentionsTx(tx, postID, rows); err != nil {
+		return nil, fmt.Errorf("replace post mentions: %w", err)
+	}
+	return rows, nil
+}
+
+// DeletePostMentions removes all mentions of a post.
+func DeletePostMentions(ctx context.Context, postID int64) error {
+	return post_mention_repo.DeleteMentionsByPostID(ctx, postID)
+}
+
+// GetMentionsByPostIDs returns mention spans of posts, key = post_id.
+func GetMentionsByPostIDs(ctx context.Context, postIDs []int64) (map[int64][]domain.PostMention, error) {
+	return post_mention_repo.ListMentionsByPostIDs(ctx, postIDs)
+}
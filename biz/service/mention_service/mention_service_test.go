@@ -0,0 +1,38 @@
+package mention_service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMentions(t *testing.T) {
+	tokens := ParseMentions("hi @alice and @bob.")
+	assert.Equal(t, []Token{
+		{Username: "alice", Start: 3, End: 9},
+		{Username: "bob", Start: 14, End: 18},
+	}, tokens)
+}
+
+func TestParseMentionsRuneOffsets(t *testing.T) {
+	// offsets are in runes, not bytes
+	tokens := ParseMentions("你好＠小明，见 @zhang_san")
+	assert.Equal(t, []Token{
+		{Username: "小明", Start: 2, End: 5},
+		{Username: "zhang_san", Start: 8, End: 18},
+	}, tokens)
+
+	runes := []rune("你好＠小明，见 @zhang_san")
+	assert.Equal(t, "＠小明", string(runes[tokens[0].Start:tokens[0].End]))
+}
+
+func TestParseMentionsIgnores(t *testing.T) {
+	assert.Empty(t, ParseMentions("mail me at bob@example.com"))
+	assert.Empty(t, ParseMentions("@ alone, @@ and trailing @"))
+
+	long := "@"
+	for i := 0; i < MaxUsernameLength+1; i++ {
+		long += "a"
+	}
+	assert.Empty(t, ParseMentions(long))
+}
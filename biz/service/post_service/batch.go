@@ -0,0 +1,91 @@
+package post_service
+
+import (
+	"context"
+	"fmt"
+
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+)
+
+// MaxBatchGetPosts is the most ids BatchGetPosts accepts in one call.
+const MaxBatchGetPosts = 50
+
+var ErrTooManyPosts = fmt.Errorf("at most %d posts can be fetched at once", MaxBatchGetPosts)
+
+// BatchPostsResult is the result of BatchGetPosts.
+type BatchPostsResult struct {
+	Posts       []domain.Post // in the order of the requested ids
+	QuotedPosts map[int64]domain.Post
+	MissingIDs  []int64 // not found / not published / not visible to the viewer
+}
+
+// normalizeBatchIDs drops invalid (<= 0) and duplicated ids, keeping the first occurrence.
+func normalizeBatchIDs(ids []int64) ([]int64, error) {
+	seen := make(map[int64]struct{}, len(ids))
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	if len(res) > MaxBatchGetPosts {
+		return nil, ErrTooManyPosts
+	}
+	return res, nil
+}
+
+// BatchGetPosts returns the published posts among ids that viewerID can see, built in one
+// buildPostLists pass (stats / names / like & fav flags / polls / mentions).
+//
+// View policy: unlike GetPost, view_count is NOT incremented. Batch reads come from
+// notifications / bookmarks / link previews rendering a list, not from someone opening a post,
+// the same as feeds.
+func BatchGetPosts(ctx context.Context, ids []int64, viewerID int64) (*BatchPostsResult, error) {
+	ids, err := normalizeBatchIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &BatchPostsResult{
+		Posts:       []domain.Post{},
+		QuotedPosts: map[int64]domain.Post{},
+		MissingIDs:  []int64{},
+	}
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	bases, err := post_base_repo.GetPublishedPostBasesByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load posts: %w", err)
+	}
+
+	// buildPostLists 会按 viewer 过滤掉看不到的帖子
+	posts, err := buildPostLists(ctx, bases, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed building posts: %w", err)
+	}
+
+	byID := make(map[int64]domain.Post, len(posts))
+	for _, p := range posts {
+		byID[p.PostBase.ID] = p
+	}
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			res.Posts = append(res.Posts, p)
+		} else {
+			res.MissingIDs = append(res.MissingIDs, id)
+		}
+	}
+
+	if res.QuotedPosts, err = getQuotedPostsByIDs(ctx, res.Posts, viewerID); err != nil {
+		return nil, fmt.Errorf("failed building quoted posts: %w", err)
+	}
+	return res, nil
+}
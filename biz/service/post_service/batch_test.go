@@ -0,0 +1,23 @@
+package post_service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeBatchIDs(t *testing.T) {
+	ids, err := normalizeBatchIDs([]int64{3, 1, 3, 0, -2, 2, 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 1, 2}, ids)
+
+	many := make([]int64, 0, MaxBatchGetPosts+1)
+	for i := 1; i <= MaxBatchGetPosts; i++ {
+		many = append(many, int64(i))
+	}
+	_, err = normalizeBatchIDs(append(many, many...))
+	assert.NoError(t, err)
+
+	_, err = normalizeBatchIDs(append(many, MaxBatchGetPosts+1))
+	assert.ErrorIs(t, err, ErrTooManyPosts)
+}
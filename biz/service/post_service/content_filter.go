@@ -0,0 +1,113 @@
+package post_service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"zetian-personal-website-hertz/biz/config"
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/pkg/contentfilter"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_report_repo"
+
+	"gorm.io/gorm"
+)
+
+/*
+Content filter
+--------------
+Title / content of CreatePost and EditPost go through a contentfilter.Pipeline built from
+config content_filter (banned words, links, repeated characters, length):
+  - reject: the request fails with ErrContentRejected;
+  - mask:   the masked text is saved;
+  - review: the text is saved but the post is hidden and put into the moderation queue
+            as a system report (reporter 0), see reports.go. Dismissing it shows the post.
+*/
+
+const (
+	defaultMaxTitleLength   = 100
+	defaultMaxContentLength = 10000
+	defaultMaxLinks         = 5
+	defaultMaxRepeatedChars = 20
+)
+
+var ErrContentRejected = errors.New("content rejected")
+
+// contentFilters builds the pipeline from config. Cheap, so it is built per call
+// and config changes apply to the next post.
+func contentFilters() contentfilter.Pipeline {
+	cfg := config.GetSpecificConfig().ContentFilter
+
+	orDefault := func(v, def int) int {
+		if v <= 0 {
+			return def
+		}
+		return v
+	}
+	// masking makes no sense for links / repeats, treat it as reject
+	noMask := func(s string) contentfilter.Action {
+		a := contentfilter.ParseAction(s, contentfilter.Reject)
+		if a == contentfilter.Mask {
+			return contentfilter.Reject
+		}
+		return a
+	}
+	wordRule := func(c config.BannedWordsConfig) contentfilter.WordRule {
+		return contentfilter.WordRule{Words: c.Words, Action: contentfilter.ParseAction(c.Action, contentfilter.Mask)}
+	}
+
+	schools := make(map[int64]contentfilter.WordRule, len(cfg.SchoolBannedWords))
+	for id, c := range cfg.SchoolBannedWords {
+		schools[id] = wordRule(c)
+	}
+
+	// banned words first, so the other filters check the masked text
+	return contentfilter.Pipeline{
+		contentfilter.BannedWordFilter{Global: wordRule(cfg.BannedWords), Schools: schools},
+		contentfilter.LengthFilter{
+			MaxTitle:   orDefault(cfg.MaxTitleLength, defaultMaxTitleLength),
+			MaxContent: orDefault(cfg.MaxContentLength, defaultMaxContentLength),
+		},
+		contentfilter.LinkFilter{MaxLinks: orDefault(cfg.MaxLinks, defaultMaxLinks), Action: noMask(cfg.LinkAction)},
+		contentfilter.RepeatFilter{MaxRun: orDefault(cfg.MaxRepeatedChars, defaultMaxRepeatedChars), Action: noMask(cfg.RepeatAction)},
+	}
+}
+
+// filterPostText runs the pipeline on a post's text. Returns ErrContentRejected (with the reasons)
+// if the text is refused; otherwise the verdict holds the text to save and whether to hold it.
+func filterPostText(schoolID int64, title, content string) (contentfilter.Verdict, error) {
+	v := contentFilters().Run(contentfilter.Input{SchoolID: schoolID, Title: title, Content: content})
+	if v.Action == contentfilter.Reject {
+		return v, fmt.Errorf("%w: %s", ErrContentRejected, strings.Join(v.Reasons, "; "))
+	}
+	return v, nil
+}
+
+// holdPostForReviewTx hides a post the filter wants reviewed and files a system report
+// (+ audit row), so it shows up in the moderation queue. Runs inside the caller's write
+// transaction; returns whether the post was visible before, the caller then drops its post_tags.
+func holdPostForReviewTx(tx *gorm.DB, base domain.PostBase, reasons []string) (bool, error) {
+	note := strings.Join(reasons, "; ")
+	changed, err := post_base_repo.SetPostHiddenTx(tx, base.ID, true)
+	if err != nil {
+		return false, err
+	}
+	err = post_report_repo.UpsertSystemReportTx(tx, &domain.PostReport{
+		PostID: base.ID,
+		Reason: domain.ReportReasonContentFilter,
+		Detail: note,
+		Status: domain.ReportStatusPending,
+	})
+	if err != nil {
+		return false, err
+	}
+	err = post_report_repo.CreateModerationActionTx(tx, &domain.ModerationAction{
+		ModeratorID:  0,
+		PostID:       base.ID,
+		TargetUserID: base.UserID,
+		Action:       domain.ModerationHold,
+		Note:         note,
+	})
+	return changed, err
+}
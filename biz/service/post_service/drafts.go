@@ -0,0 +1,312 @@
+package post_service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/pkg/contentfilter"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_stats_repo"
+	"zetian-personal-website-hertz/biz/service/hot_score_service"
+	"zetian-personal-website-hertz/biz/service/mention_service"
+	"zetian-personal-website-hertz/biz/service/tag_service"
+
+	"gorm.io/gorm"
+)
+
+/*
+Drafts / scheduled publishing
+-----------------------------
+A post is created in one of three states (post_bases.status):
+  - draft:     only visible to the author, published by hand (PublishDraft).
+  - scheduled: like a draft, but published by the background publisher once publish_at <= now.
+  - published: a normal post.
+
+Feeds only ever list published posts. When a post is published its created_at is set
+to the publish time, so a queued announcement shows up at the top of the feeds.
+post_tags / post_mentions rows are only written at publish time (they drive tag feeds /
+trending / the mentions feed).
+
+Drafts go through the content filter when saved (rejected / masked like CreatePost) and again
+when published: a post the filter wants reviewed is published hidden and held for review.
+*/
+
+const (
+	draftPublishInterval  = time.Minute
+	draftPublishBatchSize = 100
+)
+
+var (
+	ErrDraftIncomplete  = errors.New("title and content are required to publish")
+	ErrInvalidPublishAt = errors.New("publish_at must be a RFC3339 time in the future")
+)
+
+var publisherStartOnce sync.Once
+
+// DraftInput is the full content of a draft; UpdateDraft replaces every field with it.
+type DraftInput struct {
+	SchoolID   int64
+	CategoryID int64
+	Title      string
+	Content    string
+	MediaType  string
+	MediaUrls  []string
+	Location   *string
+	Tags       []string
+	ReplyTo    *int64
+	PublishAt  string // empty => plain draft, otherwise scheduled
+	Visibility string // empty => public
+}
+
+// draftFields validates in and returns the columns to store (status / publish_at included).
+// title / content are the text after the content filter (banned words masked).
+func draftFields(in DraftInput, now time.Time) (map[string]any, error) {
+	visibility, err := normalizeVisibility(in.Visibility, in.SchoolID)
+	if err != nil {
+		return nil, err
+	}
+	verdict, err := filterPostText(in.SchoolID, in.Title, in.Content)
+	if err != nil {
+		return nil, err
+	}
+	in.Title, in.Content = verdict.Title, verdict.Content
+	if in.MediaType == "" {
+		in.MediaType = "text"
+	}
+	if in.MediaUrls == nil {
+		in.MediaUrls = []string{}
+	}
+	if in.Tags == nil {
+		in.Tags = []string{}
+	}
+	mediaUrlsJSON, err := json.Marshal(in.MediaUrls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal media urls: %w", err)
+	}
+	tagsJSON, err := json.Marshal(in.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal tags: %w", err)
+	}
+
+	status := domain.PostStatusDraft
+	var publishAt *time.Time
+	if in.PublishAt != "" {
+		t, err := time.Parse(time.RFC3339, in.PublishAt)
+		if err != nil || !t.After(now) {
+			return nil, ErrInvalidPublishAt
+		}
+		// 定时发布没人再检查一遍，保存时就要求内容完整
+		if strings.TrimSpace(in.Title) == "" || strings.TrimSpace(in.Content) == "" {
+			return nil, ErrDraftIncomplete
+		}
+		status = domain.PostStatusScheduled
+		publishAt = &t
+	}
+
+	return map[string]any{
+		"school_id":   in.SchoolID,
+		"category_id": in.CategoryID,
+		"title":       in.Title,
+		"content":     in.Content,
+		"media_type":  in.MediaType,
+		"media_urls":  string(mediaUrlsJSON),
+		"location":    in.Location,
+		"tags":        string(tagsJSON),
+		"reply_to":    in.ReplyTo,
+		"visibility":  visibility,
+		"status":      status,
+		"publish_at":  publishAt,
+		"updated_at":  now,
+	}, nil
+}
+
+// SaveDraft creates a new draft (or a scheduled post if in.PublishAt is set).
+func SaveDraft(ctx context.Context, userID int64, in DraftInput) (*domain.Post, error) {
+	now := time.Now()
+	fields, err := draftFields(in, now)
+	if err != nil {
+		return nil, err
+	}
+
+	base := &domain.PostBase{
+		UserID:     userID,
+		SchoolID:   in.SchoolID,
+		CategoryID: in.CategoryID,
+		Title:      fields["title"].(string),
+		Content:    fields["content"].(string),
+		MediaType:  fields["media_type"].(string),
+		MediaUrls:  fields["media_urls"].(string),
+		Location:   in.Location,
+		Tags:       fields["tags"].(string),
+		ReplyTo:    in.ReplyTo,
+		Visibility: fields["visibility"].(string),
+		Status:     fields["status"].(string),
+		PublishAt:  fields["publish_at"].(*time.Time),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	// 草稿和它的 post_stats 行一起写，和 CreatePost 一样
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := post_base_repo.CreatePostBaseTx(tx, base); err != nil {
+			return fmt.Errorf("failed to create draft: %w", err)
+		}
+		if err := post_stats_repo.CreateEmptyStatsTx(tx, base.ID); err != nil {
+			return fmt.Errorf("failed to create post stats: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return GetPost(ctx, base.ID, userID, "")
+}
+
+// UpdateDraft replaces the content of a draft / scheduled post of userID.
+// Returns gorm.ErrRecordNotFound if the post is not an unpublished post of userID.
+func UpdateDraft(ctx context.Context, userID, postID int64, in DraftInput) (*domain.Post, error) {
+	fields, err := draftFields(in, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := post_base_repo.UpdateDraftPostBase(ctx, userID, postID, fields); err != nil {
+		return nil, err
+	}
+	return GetPost(ctx, postID, userID, "")
+}
+
+// PublishDraft publishes a draft / scheduled post of userID right now.
+// Returns gorm.ErrRecordNotFound if the post is not an unpublished post of userID.
+func PublishDraft(ctx context.Context, userID, postID int64) (*domain.Post, error) {
+	base, err := post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if base.UserID != userID || base.Status == domain.PostStatusPublished {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if strings.TrimSpace(base.Title) == "" || strings.TrimSpace(base.Content) == "" {
+		return nil, ErrDraftIncomplete
+	}
+
+	if err := publishPost(ctx, postID, userID, time.Now()); err != nil {
+		return nil, err
+	}
+	return GetPost(ctx, postID, userID, "")
+}
+
+// GetMyDrafts returns drafts and scheduled posts of userID, latest updated first.
+func GetMyDrafts(ctx context.Context, userID int64, limit int) ([]domain.Post, error) {
+	bases, err := post_base_repo.ListDraftsByUserID(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drafts: %w", err)
+	}
+	return buildPostLists(ctx, bases, userID)
+}
+
+// publishPost flips the post to published (userID <= 0 => scheduler) and
+// does what CreatePost does after inserting: post_tags + mentions + hot score.
+// The text is checked by the content filter again (the rules may have changed since the
+// draft was saved); if it is not fine any more the post is held for review in the same
+// transaction as the status change, so it never goes out unheld and a failed publish
+// leaves no report behind.
+func publishPost(ctx context.Context, postID, userID int64, now time.Time) error {
+	base, err := post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	// rejected text was accepted when saved, so a moderator decides instead of failing the publish
+	verdict, filterErr := filterPostText(base.SchoolID, base.Title, base.Content)
+	hold := filterErr != nil || verdict.Action == contentfilter.Review
+
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := post_base_repo.PublishPostBaseTx(tx, postID, userID, now); err != nil {
+			return err
+		}
+		if hold {
+			if _, err := holdPostForReviewTx(tx, *base, verdict.Reasons); err != nil {
+				return fmt.Errorf("failed to hold post for review: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	base, err = post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
+		return fmt.Errorf("failed to load published post: %w", err)
+	}
+	if base.IsListed() {
+		if err := tag_service.SyncPostTagsFromBase(ctx, *base); err != nil {
+			return fmt.Errorf("failed to sync post tags: %w", err)
+		}
+	}
+	if _, err := mention_service.SyncPostMentions(ctx, postID, base.Content); err != nil {
+		return fmt.Errorf("failed to sync post mentions: %w", err)
+	}
+	adjustQuoteCount(ctx, *base, 1)
+	hot_score_service.Touch(postID)
+	return nil
+}
+
+// PublishDuePosts publishes every scheduled post whose publish_at <= now.
+// Returns how many posts were published.
+func PublishDuePosts(ctx context.Context) (int, error) {
+	published := 0
+	for {
+		now := time.Now()
+		ids, err := post_base_repo.ListDuePostIDs(ctx, now, draftPublishBatchSize)
+		if err != nil {
+			return published, err
+		}
+		if len(ids) == 0 {
+			return published, nil
+		}
+
+		failed := 0
+		for _, id := range ids {
+			if err := publishPost(ctx, id, 0, now); err != nil {
+				log.Printf("draft publisher: publish post %d failed: %v", id, err)
+				failed++
+				continue
+			}
+			published++
+		}
+		// 整批都失败就先停下，等下一轮再试，避免死循环
+		if failed == len(ids) {
+			return published, nil
+		}
+	}
+}
+
+// StartDraftPublisher starts the background goroutine that publishes due scheduled posts.
+// Calling it more than once has no effect.
+func StartDraftPublisher() {
+	publisherStartOnce.Do(func() {
+		go func() {
+			ctx := context.Background()
+			ticker := time.NewTicker(draftPublishInterval)
+			defer ticker.Stop()
+
+			for {
+				if n, err := PublishDuePosts(ctx); err != nil {
+					log.Printf("draft publisher: %v", err)
+				} else if n > 0 {
+					log.Printf("draft publisher: %d posts published", n)
+				}
+				<-ticker.C
+			}
+		}()
+	})
+}
@@ -0,0 +1,121 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/pkg/cursor"
+	"zetian-personal-website-hertz/biz/pkg/geo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+)
+
+const (
+	defaultNearbyRadiusM = 2000
+	maxNearbyRadiusM     = 50000
+)
+
+var ErrInvalidLocation = errors.New("invalid location")
+
+// normalizeCoordinates validates optional post coordinates and rounds them for privacy.
+// Both or neither must be set.
+func normalizeCoordinates(lat, lng *float64) (*float64, *float64, error) {
+	if lat == nil && lng == nil {
+		return nil, nil, nil
+	}
+	if lat == nil || lng == nil {
+		return nil, nil, fmt.Errorf("%w: latitude and longitude must be given together", ErrInvalidLocation)
+	}
+	if err := geo.Validate(*lat, *lng); err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrInvalidLocation, err)
+	}
+	rLat, rLng := geo.Round(*lat), geo.Round(*lng)
+	return &rLat, &rLng, nil
+}
+
+// GetNearbyPosts returns posts within radiusM meters of (lat, lng), nearest first.
+//   - radiusM <= 0 means the default radius, too large is capped.
+//   - (lat, lng) is rounded like post coordinates (geo.CoordPrecision) before distances are computed.
+//   - cursorStr is the NextCursor of previous page, "" for the first page; it only works
+//     for the same (rounded) point it was issued for.
+func GetNearbyPosts(
+	ctx context.Context,
+	lat, lng float64,
+	radiusM int,
+	viewerID int64,
+	cursorStr string,
+	limit int,
+) (*PostPage, error) {
+
+	if err := geo.Validate(lat, lng); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLocation, err)
+	}
+	// 查询点也按 CoordPrecision 取整：cursor 只绑定取整后的点，距离必须从同一个点算，
+	// 否则换一个取整相同的点翻页，keyset 的距离对不上，会跳过或重复帖子
+	lat, lng = geo.Round(lat), geo.Round(lng)
+	if radiusM <= 0 {
+		radiusM = defaultNearbyRadiusM
+	}
+	if radiusM > maxNearbyRadiusM {
+		radiusM = maxNearbyRadiusM
+	}
+
+	cursorDist, cursorID, err := decodeNearbyCursor(cursorStr, lat, lng)
+	if err != nil {
+		return nil, err
+	}
+
+	// 多取 1 条判断 hasMore
+	rows, err := post_base_repo.ListNearbyPosts(
+		ctx, loadPostViewer(ctx, viewerID), lat, lng, float64(radiusM), cursorDist, cursorID, limit+1,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nearby posts: %w", err)
+	}
+
+	bases := make([]domain.PostBase, 0, len(rows))
+	distances := make(map[int64]float64, len(rows))
+	for _, r := range rows {
+		bases = append(bases, r.PostBase)
+		distances[r.ID] = r.Distance
+	}
+
+	page, err := buildPostPage(ctx, bases, limit, viewerID, func(last domain.PostBase) string {
+		return encodeNearbyCursor(distances[last.ID], last.ID, lat, lng)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range page.Posts {
+		d := distances[page.Posts[i].PostBase.ID]
+		page.Posts[i].DistanceM = &d
+	}
+	return page, nil
+}
+
+// nearby cursor: (distance bits, post id, lat, lng of the query point in CoordPrecision units)
+// distance is kept as raw float64 bits so the keyset comparison in SQL is exact.
+func encodeNearbyCursor(distance float64, postID int64, lat, lng float64) string {
+	latE, lngE := coordKey(lat), coordKey(lng)
+	return cursor.Encode(cursorSecret(), "nearby", int64(math.Float64bits(distance)), postID, latE, lngE)
+}
+
+func decodeNearbyCursor(cursorStr string, lat, lng float64) (distance float64, postID int64, err error) {
+	if cursorStr == "" {
+		return 0, 0, nil
+	}
+	v, err := cursor.Decode(cursorSecret(), "nearby", cursorStr, 4)
+	if err != nil {
+		return 0, 0, err
+	}
+	if v[2] != coordKey(lat) || v[3] != coordKey(lng) {
+		return 0, 0, cursor.ErrInvalidCursor
+	}
+	return math.Float64frombits(uint64(v[0])), v[1], nil
+}
+
+func coordKey(v float64) int64 {
+	return int64(math.Round(v * math.Pow(10, geo.CoordPrecision)))
+}
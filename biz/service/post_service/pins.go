@@ -0,0 +1,107 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/user_repo"
+
+	"gorm.io/gorm"
+)
+
+// A user can pin up to MaxPinnedPosts of their own published posts to their profile.
+// Pinned posts come first on the first page of GetPersonalRecentPosts (latest pinned first)
+// and are left out of the time-ordered part, so they are never listed twice.
+// Moving a post to the trash or hiding it (moderation / content filter hold) unpins it.
+const MaxPinnedPosts = 3
+
+var (
+	ErrNotPostOwner  = errors.New("you can only pin or unpin your own posts")
+	ErrTooManyPinned = fmt.Errorf("you can pin at most %d posts", MaxPinnedPosts)
+)
+
+// PinPost pins postID to userID's profile. Pinning a pinned post is a no-op.
+//   - gorm.ErrRecordNotFound if the post does not exist / is not published / is hidden.
+//   - ErrNotPostOwner / ErrTooManyPinned for rejected pins.
+func PinPost(ctx context.Context, userID, postID int64) error {
+	base, err := post_base_repo.GetPublishedPostBaseByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if base.UserID != userID {
+		return ErrNotPostOwner
+	}
+	if base.PinnedAt != nil {
+		return nil
+	}
+
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 锁住 user 行，同一用户并发置顶时不会超过上限
+		if err := user_repo.LockUserTx(tx, userID); err != nil {
+			return err
+		}
+		n, err := post_base_repo.CountPinnedPostsTx(tx, userID)
+		if err != nil {
+			return err
+		}
+		if n >= MaxPinnedPosts {
+			return ErrTooManyPinned
+		}
+		now := time.Now()
+		_, err = post_base_repo.SetPostPinnedTx(tx, userID, postID, &now)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, ErrTooManyPinned) {
+			return err
+		}
+		return fmt.Errorf("failed to pin post: %w", err)
+	}
+	return nil
+}
+
+// UnpinPost unpins postID. Unpinning a post that is not pinned is a no-op.
+// Returns gorm.ErrRecordNotFound if the post does not exist, ErrNotPostOwner if it is not userID's.
+func UnpinPost(ctx context.Context, userID, postID int64) error {
+	base, err := post_base_repo.GetPostBaseByIDUnscoped(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if base.UserID != userID {
+		return ErrNotPostOwner
+	}
+	if _, err := post_base_repo.SetPostPinnedTx(DB.DB.WithContext(ctx), userID, postID, nil); err != nil {
+		return fmt.Errorf("failed to unpin post: %w", err)
+	}
+	return nil
+}
+
+// prependPinnedPosts puts the pinned posts of userID (as seen by viewerID) in front of page.
+func prependPinnedPosts(ctx context.Context, page *PostPage, userID, viewerID int64) error {
+	bases, err := post_base_repo.ListPinnedPostsByUserID(ctx, loadPostViewer(ctx, viewerID), userID)
+	if err != nil {
+		return fmt.Errorf("failed to list pinned posts: %w", err)
+	}
+	if len(bases) == 0 {
+		return nil
+	}
+
+	pinned, err := buildPostLists(ctx, bases, viewerID)
+	if err != nil {
+		return fmt.Errorf("failed building pinned posts: %w", err)
+	}
+	quoted, err := getQuotedPostsByIDs(ctx, pinned, viewerID)
+	if err != nil {
+		return fmt.Errorf("failed building quoted posts: %w", err)
+	}
+
+	page.Posts = append(pinned, page.Posts...)
+	for id, p := range quoted {
+		page.QuotedPosts[id] = p
+	}
+	return nil
+}
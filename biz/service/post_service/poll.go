@@ -0,0 +1,212 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_poll_repo"
+
+	"gorm.io/gorm"
+)
+
+/*
+Polls
+-----
+A post can carry one poll (question + 2~10 options, optional multi-choice and close time).
+Every user votes once and cannot change the vote:
+  - poll_votes (post_id, user_id) primary key decides who voted, a second vote hits the
+    conflict and is rejected, so two concurrent votes of the same user can't both count.
+  - vote_count / total_voters are bumped with "col = col + 1" in the same transaction,
+    concurrent votes of different users never overwrite each other.
+*/
+
+const (
+	minPollOptions      = 2
+	maxPollOptions      = 10
+	maxPollQuestionLen  = 255
+	maxPollOptionLength = 100
+)
+
+var (
+	ErrInvalidPoll  = errors.New("invalid poll")
+	ErrPollClosed   = errors.New("poll is closed")
+	ErrAlreadyVoted = errors.New("you have already voted")
+	ErrInvalidVote  = errors.New("invalid poll options")
+)
+
+// PollInput is a poll attached to a new post.
+type PollInput struct {
+	Question    string
+	Options     []string
+	MultiChoice bool
+	ClosesAt    string // RFC3339, empty => never closes
+}
+
+// buildPoll validates in and returns the poll to insert (PostID is filled in by the caller).
+func buildPoll(in PollInput, now time.Time) (*domain.Poll, error) {
+	question := strings.TrimSpace(in.Question)
+	if question == "" || utf8.RuneCountInString(question) > maxPollQuestionLen {
+		return nil, fmt.Errorf("%w: question must be 1 ~ %d characters", ErrInvalidPoll, maxPollQuestionLen)
+	}
+	if len(in.Options) < minPollOptions || len(in.Options) > maxPollOptions {
+		return nil, fmt.Errorf("%w: a poll needs %d ~ %d options", ErrInvalidPoll, minPollOptions, maxPollOptions)
+	}
+
+	poll := &domain.Poll{
+		Question:    question,
+		MultiChoice: in.MultiChoice,
+		Options:     make([]domain.PollOption, 0, len(in.Options)),
+	}
+
+	seen := make(map[string]struct{}, len(in.Options))
+	for i, text := range in.Options {
+		text = strings.TrimSpace(text)
+		if text == "" || utf8.RuneCountInString(text) > maxPollOptionLength {
+			return nil, fmt.Errorf("%w: option must be 1 ~ %d characters", ErrInvalidPoll, maxPollOptionLength)
+		}
+		if _, ok := seen[text]; ok {
+			return nil, fmt.Errorf("%w: duplicate option %q", ErrInvalidPoll, text)
+		}
+		seen[text] = struct{}{}
+		poll.Options = append(poll.Options, domain.PollOption{Position: int32(i), Text: text})
+	}
+
+	if in.ClosesAt != "" {
+		t, err := time.Parse(time.RFC3339, in.ClosesAt)
+		if err != nil || !t.After(now) {
+			return nil, fmt.Errorf("%w: closes_at must be a RFC3339 time in the future", ErrInvalidPoll)
+		}
+		poll.ClosesAt = &t
+	}
+	return poll, nil
+}
+
+// normalizeVoteOptions dedups optionIDs and checks they fit the poll.
+func normalizeVoteOptions(poll *domain.Poll, optionIDs []int64) ([]int64, error) {
+	valid := make(map[int64]struct{}, len(poll.Options))
+	for _, o := range poll.Options {
+		valid[o.ID] = struct{}{}
+	}
+
+	seen := make(map[int64]struct{}, len(optionIDs))
+	ids := make([]int64, 0, len(optionIDs))
+	for _, id := range optionIDs {
+		if _, ok := valid[id]; !ok {
+			return nil, ErrInvalidVote
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	if len(ids) == 0 || (!poll.MultiChoice && len(ids) > 1) {
+		return nil, ErrInvalidVote
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// VotePoll lets userID vote on the poll of postID.
+//   - Returns gorm.ErrRecordNotFound if the post / poll does not exist or the user can't see the post.
+//   - ErrPollClosed / ErrInvalidVote / ErrAlreadyVoted for rejected votes.
+func VotePoll(ctx context.Context, userID, postID int64, optionIDs []int64) (*domain.PollView, error) {
+	base, err := post_base_repo.GetPublishedPostBaseByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if visible, err := canViewPost(ctx, base, userID); err != nil {
+		return nil, err
+	} else if !visible {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	poll, err := post_poll_repo.GetPollByPostID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if poll.IsClosed(time.Now()) {
+		return nil, ErrPollClosed
+	}
+	ids, err := normalizeVoteOptions(poll, optionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		inserted, err := post_poll_repo.CreateVoteTx(tx, postID, userID)
+		if err != nil {
+			return err
+		}
+		if !inserted {
+			return ErrAlreadyVoted
+		}
+
+		choices := make([]domain.PollVoteChoice, 0, len(ids))
+		for _, id := range ids {
+			choices = append(choices, domain.PollVoteChoice{PostID: postID, UserID: userID, OptionID: id})
+		}
+		if err := post_poll_repo.CreateVoteChoicesTx(tx, choices); err != nil {
+			return err
+		}
+
+		n, err := post_poll_repo.IncrementOptionVotesTx(tx, postID, ids)
+		if err != nil {
+			return err
+		}
+		if n != int64(len(ids)) {
+			return ErrInvalidVote
+		}
+		return post_poll_repo.IncrementTotalVotersTx(tx, postID)
+	})
+	if err != nil {
+		if errors.Is(err, ErrAlreadyVoted) || errors.Is(err, ErrInvalidVote) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to vote: %w", err)
+	}
+
+	poll, err = post_poll_repo.GetPollByPostID(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load poll: %w", err)
+	}
+	return &domain.PollView{Poll: *poll, Voted: true, MyOptionIDs: ids}, nil
+}
+
+// loadPollViews returns polls of postIDs as seen by viewerID, key = post_id.
+func loadPollViews(ctx context.Context, postIDs []int64, viewerID int64) (map[int64]*domain.PollView, error) {
+	polls, err := post_poll_repo.GetPollsByPostIDs(ctx, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]*domain.PollView, len(polls))
+	if len(polls) == 0 {
+		return res, nil
+	}
+
+	choices := map[int64][]int64{}
+	if viewerID > 0 {
+		pollPostIDs := make([]int64, 0, len(polls))
+		for id := range polls {
+			pollPostIDs = append(pollPostIDs, id)
+		}
+		if choices, err = post_poll_repo.GetUserChoicesByPostIDs(ctx, viewerID, pollPostIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	for id, p := range polls {
+		mine, voted := choices[id]
+		res[id] = &domain.PollView{Poll: *p, Voted: voted, MyOptionIDs: mine}
+	}
+	return res, nil
+}
@@ -0,0 +1,61 @@
+package post_service
+
+import (
+	"testing"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildPoll(t *testing.T) {
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	poll, err := buildPoll(PollInput{
+		Question: "  which dining hall is better? ",
+		Options:  []string{" North ", "South"},
+		ClosesAt: "2025-11-04T12:00:00Z",
+	}, now)
+	assert.NoError(t, err)
+	assert.Equal(t, "which dining hall is better?", poll.Question)
+	assert.Equal(t, "North", poll.Options[0].Text)
+	assert.Equal(t, int32(1), poll.Options[1].Position)
+	assert.NotNil(t, poll.ClosesAt)
+
+	bad := []PollInput{
+		{Question: "", Options: []string{"a", "b"}},
+		{Question: "q", Options: []string{"a"}},
+		{Question: "q", Options: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}},
+		{Question: "q", Options: []string{"a", " a"}},
+		{Question: "q", Options: []string{"a", ""}},
+		{Question: "q", Options: []string{"a", "b"}, ClosesAt: "2025-11-03T11:00:00Z"},
+		{Question: "q", Options: []string{"a", "b"}, ClosesAt: "tomorrow"},
+	}
+	for _, in := range bad {
+		_, err := buildPoll(in, now)
+		assert.ErrorIs(t, err, ErrInvalidPoll, in)
+	}
+}
+
+func TestNormalizeVoteOptions(t *testing.T) {
+	single := &domain.Poll{Options: []domain.PollOption{{ID: 11}, {ID: 12}, {ID: 13}}}
+	multi := &domain.Poll{MultiChoice: true, Options: single.Options}
+
+	ids, err := normalizeVoteOptions(single, []int64{12})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{12}, ids)
+
+	_, err = normalizeVoteOptions(single, []int64{11, 12})
+	assert.ErrorIs(t, err, ErrInvalidVote)
+
+	ids, err = normalizeVoteOptions(multi, []int64{13, 11, 13})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{11, 13}, ids)
+
+	_, err = normalizeVoteOptions(multi, []int64{99})
+	assert.ErrorIs(t, err, ErrInvalidVote)
+
+	_, err = normalizeVoteOptions(multi, nil)
+	assert.ErrorIs(t, err, ErrInvalidVote)
+}
@@ -5,18 +5,35 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
+	"zetian-personal-website-hertz/biz/config"
 	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/pkg/contentfilter"
+	"zetian-personal-website-hertz/biz/pkg/cursor"
+	DB "zetian-personal-website-hertz/biz/repository"
 	"zetian-personal-website-hertz/biz/repository/category_repo"
 	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
 	"zetian-personal-website-hertz/biz/repository/post_repo/post_fav_repo"
 	"zetian-personal-website-hertz/biz/repository/post_repo/post_like_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_poll_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_report_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_revision_repo"
 	"zetian-personal-website-hertz/biz/repository/post_repo/post_stats_repo"
 	"zetian-personal-website-hertz/biz/repository/school_repo"
+	"zetian-personal-website-hertz/biz/repository/tag_repo"
+	"zetian-personal-website-hertz/biz/repository/user_follow_repo"
 	"zetian-personal-website-hertz/biz/repository/user_repo"
 	"zetian-personal-website-hertz/biz/repository/user_stats_repo"
+	"zetian-personal-website-hertz/biz/service/comment_service"
+	"zetian-personal-website-hertz/biz/service/hot_score_service"
+	"zetian-personal-website-hertz/biz/service/mention_service"
+	"zetian-personal-website-hertz/biz/service/picture_upload_service"
+	"zetian-personal-website-hertz/biz/service/tag_service"
+	"zetian-personal-website-hertz/biz/service/view_service"
+	"zetian-personal-website-hertz/biz/service/visibility_service"
 
 	"gorm.io/gorm"
 )
@@ -28,13 +45,20 @@ import (
 // CreatePost creates a new post.
 //
 // It will:
-//   1) Insert into posts (PostBase)
-//   2) Insert a corresponding row into post_stats (PostStats)
-//   3) Optionally resolve SchoolName from cache
+//  1. Insert into posts (PostBase)
+//  2. Insert a corresponding row into post_stats (PostStats)
+//  3. Optionally resolve SchoolName from cache
 //
 // Special behavior:
 //   - mediaUrls / tags are stored as JSON strings in DB.
 //   - ReplyTo and Location can be nil.
+//   - visibility "" means public (see visibility.go).
+//   - poll can be nil; post and poll are inserted in one transaction.
+//   - latitude / longitude are optional (both or neither) and rounded before saving (see nearby.go).
+//   - @username in content is resolved into post_mentions (see mention_service).
+//   - Quoting a post (replyTo) bumps its quote count (see quotes.go).
+//   - Title / content go through the content filter: rejected => ErrContentRejected,
+//     banned words may be masked, or the post is held for review (see content_filter.go).
 //   - Stats row starts with all zeros.
 func CreatePost(
 	ctx context.Context,
@@ -48,8 +72,36 @@ func CreatePost(
 	location *string,
 	tags []string,
 	replyTo *int64,
+	visibility string,
+	poll *PollInput,
+	latitude *float64,
+	longitude *float64,
 ) (*domain.Post, error) {
 
+	visibility, err := normalizeVisibility(visibility, schoolID)
+	if err != nil {
+		return nil, err
+	}
+	latitude, longitude, err = normalizeCoordinates(latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+	verdict, err := filterPostText(schoolID, title, content)
+	if err != nil {
+		return nil, err
+	}
+	title, content = verdict.Title, verdict.Content
+	held := verdict.Action == contentfilter.Review
+
+	now := time.Now()
+
+	var pollRow *domain.Poll
+	if poll != nil {
+		if pollRow, err = buildPoll(*poll, now); err != nil {
+			return nil, err
+		}
+	}
+
 	mediaUrlsJSON, err := json.Marshal(mediaUrls)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal media urls: %w", err)
@@ -59,34 +111,72 @@ func CreatePost(
 		return nil, fmt.Errorf("failed to marshal tags: %w", err)
 	}
 
-	now := time.Now()
-
 	base := &domain.PostBase{
-		UserID:    userID,
-		SchoolID:  schoolID,
+		UserID:     userID,
+		SchoolID:   schoolID,
 		CategoryID: categoryID,
-		Title:     title,
-		Content:   content,
-		MediaType: mediaType,
-		MediaUrls: string(mediaUrlsJSON),
-		Location:  location,
-		Tags:      string(tagsJSON),
-		ReplyTo:   replyTo,
-		CreatedAt: now,
-		UpdatedAt: now,
-	}
-
-	// 1) Create base row
-	if err := post_base_repo.CreatePostBase(ctx, base); err != nil {
-		return nil, fmt.Errorf("failed to create post: %w", err)
+		Title:      title,
+		Content:    content,
+		MediaType:  mediaType,
+		MediaUrls:  string(mediaUrlsJSON),
+		Location:   location,
+		Latitude:   latitude,
+		Longitude:  longitude,
+		Tags:       string(tagsJSON),
+		ReplyTo:    replyTo,
+		Status:     domain.PostStatusPublished,
+		Visibility: visibility,
+		Hidden:     held,
+		CreatedAt:  now,
+		UpdatedAt:  now,
 	}
 
-	// 2) Create stats row (all zeros, PostID = base.ID)
-	if err := post_stats_repo.CreateEmptyStats(ctx, base.ID); err != nil {
-		return nil, fmt.Errorf("failed to create post stats: %w", err)
+	// 1) base row, poll, stats, tags and @mentions in one transaction:
+	// a failed step leaves no half-created post behind
+	var mentions []domain.PostMention
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := post_base_repo.CreatePostBaseTx(tx, base); err != nil {
+			return err
+		}
+		if pollRow != nil {
+			pollRow.PostID = base.ID
+			for i := range pollRow.Options {
+				pollRow.Options[i].PostID = base.ID
+			}
+			if err := post_poll_repo.CreatePollTx(tx, pollRow); err != nil {
+				return err
+			}
+		}
+
+		// 2) Create stats row (all zeros, PostID = base.ID)
+		if err := post_stats_repo.CreateEmptyStatsTx(tx, base.ID); err != nil {
+			return fmt.Errorf("create post stats: %w", err)
+		}
+
+		// 3) Maintain tags / post_tags; a held post (created hidden) gets a system report instead until it is reviewed
+		if held {
+			if _, err := holdPostForReviewTx(tx, *base, verdict.Reasons); err != nil {
+				return fmt.Errorf("hold post for review: %w", err)
+			}
+		} else if err := tag_service.SyncPostTagsTx(tx, base.ID, base.SchoolID, base.CreatedAt, tags); err != nil {
+			return fmt.Errorf("sync post tags: %w", err)
+		}
+
+		// 4) @mentions
+		var err error
+		if mentions, err = mention_service.SyncPostMentionsTx(tx, base.ID, base.Content); err != nil {
+			return fmt.Errorf("sync post mentions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create post: %w", err)
 	}
 
-	// 3) Try to resolve school name from cache (best-effort)
+	// 5) quote count of the quoted post (best-effort)
+	adjustQuoteCount(ctx, *base, 1)
+
+	// 6) Try to resolve school name from cache (best-effort)
 	schoolName := ""
 	if s, err := school_repo.GetSchoolByIDInCache(base.SchoolID); err == nil && s != nil {
 		if s.ShortName != "" {
@@ -106,22 +196,89 @@ func CreatePost(
 		SchoolName:    schoolName,
 		IsLikedByUser: false,
 		IsFavByUser:   false,
+		Mentions:      mentions,
+	}
+	if pollRow != nil {
+		p.Poll = &domain.PollView{Poll: *pollRow}
 	}
 
 	return p, nil
 }
 
-// EditPost updates title/content of a post.
+// EditPost updates title/content (and optionally tags) of a post.
 // Only the owner (userID) is allowed to edit.
 // Stats / user flags are untouched.
+//   - tags == nil means tags are unchanged; an empty slice clears them.
+//   - The replaced version is saved into post_revisions and edit_count is bumped,
+//     both in the same transaction as the update (row is locked, so concurrent edits get distinct versions).
+//   - Nothing is recorded if title / content / tags are all unchanged.
+//   - The new title / content go through the content filter like CreatePost (see content_filter.go).
+//   - Returns gorm.ErrRecordNotFound if the post does not exist or is not owned by userID.
 func EditPost(
 	ctx context.Context,
 	userID, postID int64,
 	title string,
 	content string,
+	tags []string,
 ) (*domain.Post, error) {
 
-	if err := post_base_repo.UpdatePostBase(ctx, userID, postID, title, content); err != nil {
+	tagsChanged := false
+	contentChanged := false
+	heldNow := false // the edit was held for review and that hid a visible post
+	err := DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		old, err := post_base_repo.GetOwnPostBaseForUpdateTx(tx, userID, postID)
+		if err != nil {
+			return err
+		}
+
+		verdict, err := filterPostText(old.SchoolID, title, content)
+		if err != nil {
+			return err
+		}
+		title, content = verdict.Title, verdict.Content
+
+		tagsJSON := old.Tags
+		if tags != nil {
+			b, err := json.Marshal(tags)
+			if err != nil {
+				return fmt.Errorf("failed to marshal tags: %w", err)
+			}
+			tagsJSON = string(b)
+		}
+		if title == old.Title && content == old.Content && tagsJSON == old.Tags {
+			return nil
+		}
+		tagsChanged = tagsJSON != old.Tags
+		contentChanged = content != old.Content
+
+		rev := &domain.PostRevision{
+			PostID:   postID,
+			Version:  old.EditCount,
+			Title:    old.Title,
+			Content:  old.Content,
+			Tags:     old.Tags,
+			EditorID: userID,
+		}
+		if err := post_revision_repo.CreateRevisionTx(tx, rev); err != nil {
+			return fmt.Errorf("failed to save revision: %w", err)
+		}
+		if err := post_base_repo.ApplyEditTx(tx, postID, title, content, tagsJSON); err != nil {
+			return err
+		}
+
+		// hold in the same transaction: the filtered text is never visible, not even for a moment.
+		// drafts / scheduled posts are held too, publishing them later keeps them hidden
+		if verdict.Action == contentfilter.Review {
+			if heldNow, err = holdPostForReviewTx(tx, *old, verdict.Reasons); err != nil {
+				return fmt.Errorf("hold post for review: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, ErrContentRejected) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to update post: %w", err)
 	}
 
@@ -131,6 +288,29 @@ func EditPost(
 		return nil, fmt.Errorf("failed to load updated post: %w", err)
 	}
 
+	// a held post no longer counts for tags
+	if heldNow {
+		if err := tag_service.DeletePostTags(ctx, postID); err != nil {
+			return nil, fmt.Errorf("delete post tags: %w", err)
+		}
+	}
+
+	if tagsChanged && base.IsListed() {
+		if err := tag_service.SyncPostTagsFromBase(ctx, *base); err != nil {
+			return nil, fmt.Errorf("failed to sync post tags: %w", err)
+		}
+	}
+
+	if contentChanged && base.Status == domain.PostStatusPublished {
+		if _, err := mention_service.SyncPostMentions(ctx, postID, base.Content); err != nil {
+			return nil, fmt.Errorf("failed to sync post mentions: %w", err)
+		}
+	}
+	mentions, err := mention_service.GetMentionsByPostIDs(ctx, []int64{postID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load post mentions: %w", err)
+	}
+
 	// Load stats (best-effort)
 	stats, err := post_stats_repo.GetStats(ctx, postID)
 	if err != nil {
@@ -157,17 +337,114 @@ func EditPost(
 		SchoolName:    schoolName,
 		IsLikedByUser: false, // viewer not known here
 		IsFavByUser:   false,
+		Mentions:      mentions[postID],
 	}, nil
 }
 
-// DeletePost deletes a post owned by userID and clears likes/favorites.
+// DeletePost moves a post owned by userID into the trash (soft delete).
 // Notes:
-//   - Likes / favorites are deleted first (in parallel).
-//   - Base row deletion uses (user_id, id) to enforce ownership.
-//   - post_stats row is expected to be deleted by FK cascade.
+//   - The post disappears from every feed right away (gorm skips rows with deleted_at).
+//   - post_tags rows are removed so trending tags stop counting it; RestorePost re-syncs them.
+//   - The quoted post (ReplyTo) loses one quote, RestorePost gives it back.
+//   - A pinned post is unpinned by the same update (and stays unpinned after restore).
+//   - Likes / favorites / comments / media are kept until PurgePost, so restore is lossless.
 func DeletePost(ctx context.Context, userID, postID int64) error {
+	base, err := post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	// 1) soft delete post base (ownership enforced), also unpins it
+	if err := post_base_repo.DeletePostBase(ctx, userID, postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// upper layer (handler) can map this to 404 or "no permission"
+			return err
+		}
+		return fmt.Errorf("failed to delete post: %w", err)
+	}
+
+	// 2) post_tags
+	if err := tag_service.DeletePostTags(ctx, postID); err != nil {
+		return fmt.Errorf("delete post tags: %w", err)
+	}
+
+	// 3) a post in the trash no longer counts as a quote
+	adjustQuoteCount(ctx, *base, -1)
+	return nil
+}
+
+// RestorePost takes a post of userID out of the trash and returns it.
+// Returns gorm.ErrRecordNotFound if the post is not in userID's trash (or already purged).
+func RestorePost(ctx context.Context, userID, postID int64) (*domain.Post, error) {
+	if err := post_base_repo.RestorePostBase(ctx, userID, postID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to restore post: %w", err)
+	}
+
+	base, err := post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load restored post: %w", err)
+	}
+	// drafts never had post_tags rows, they get them when published; hidden posts get none
+	if base.IsListed() {
+		if err := tag_service.SyncPostTagsFromBase(ctx, *base); err != nil {
+			return nil, fmt.Errorf("failed to sync post tags: %w", err)
+		}
+	}
+	adjustQuoteCount(ctx, *base, 1)
+
+	return GetPost(ctx, postID, userID, "")
+}
+
+// GetTrashedPosts returns the trash of userID (latest deleted first), with the time each post will be purged.
+func GetTrashedPosts(ctx context.Context, userID int64, limit int) ([]domain.TrashedPost, error) {
+	bases, err := post_base_repo.ListTrashedPostsByUserID(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trashed posts: %w", err)
+	}
+	if len(bases) == 0 {
+		return []domain.TrashedPost{}, nil
+	}
+
+	posts, err := buildPostLists(ctx, bases, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed building posts: %w", err)
+	}
+
+	retention := trashRetention()
+	res := make([]domain.TrashedPost, 0, len(posts))
+	for _, p := range posts {
+		res = append(res, domain.TrashedPost{
+			Post:    p,
+			PurgeAt: p.PostBase.DeletedAt.Time.Add(retention),
+		})
+	}
+	return res, nil
+}
+
+// PurgePost really deletes a post (trashed or not) and everything hanging on it.
+// Called by the trash purger after the retention period.
+// Notes:
+//   - Likes / favorites / comments / tags / revisions / reports are deleted first (in parallel).
+//   - S3 media is deleted best-effort.
+//   - post_stats row is deleted together with the post row (no FK cascade in the schema).
+//   - A post that no longer exists is not an error.
+func PurgePost(ctx context.Context, postID int64) error {
+	base, err := post_base_repo.GetPostBaseByIDUnscoped(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get post base: %w", err)
+	}
+
 	var wg sync.WaitGroup
-	errChan := make(chan error, 3)
+	errChan := make(chan error, 9)
 
 	// 1) delete likes
 	wg.Add(1)
@@ -187,12 +464,66 @@ func DeletePost(ctx context.Context, userID, postID int64) error {
 		}
 	}()
 
-	// 3) decrement author's received-like count
+	// 3) delete comments (and comment likes)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := comment_service.DeleteCommentsByPostID(ctx, postID); err != nil {
+			errChan <- fmt.Errorf("delete comments: %w", err)
+		}
+	}()
+
+	// 4) delete post_tags
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := tag_service.DeletePostTags(ctx, postID); err != nil {
+			errChan <- fmt.Errorf("delete post tags: %w", err)
+		}
+	}()
+
+	// 5) delete edit history
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := post_revision_repo.DeleteRevisionsByPostID(ctx, postID); err != nil {
+			errChan <- fmt.Errorf("delete revisions: %w", err)
+		}
+	}()
+
+	// 6) delete poll (options / votes)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := post_poll_repo.DeletePollByPostID(ctx, postID); err != nil {
+			errChan <- fmt.Errorf("delete poll: %w", err)
+		}
+	}()
+
+	// 7) delete mentions
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		if err := mention_service.DeletePostMentions(ctx, postID); err != nil {
+			errChan <- fmt.Errorf("delete mentions: %w", err)
+		}
+	}()
+
+	// 8) delete reports (moderation_actions are kept)
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
+		if err := post_report_repo.DeleteReportsByPostID(ctx, postID); err != nil {
+			errChan <- fmt.Errorf("delete reports: %w", err)
+		}
+	}()
 
-		// 3.1 拿 stats（like_count）
+	// 9) decrement author's received-like count
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		// 9.1 拿 stats（like_count）
 		stats, err := post_stats_repo.GetStats(ctx, postID)
 		if err != nil {
 			if !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -206,17 +537,10 @@ func DeletePost(ctx context.Context, userID, postID int64) error {
 			return
 		}
 
-		// 3.2 拿作者 id
-		base, err := post_base_repo.GetPostBaseByID(ctx, postID)
-		if err != nil {
-			if !errors.Is(err, gorm.ErrRecordNotFound) {
-				errChan <- fmt.Errorf("get post base: %w", err)
-			}
-			return
-		}
+		// 9.2 作者 id
 		authorID := base.UserID
 
-		// 3.3 给作者的「收到的点赞数」减去该帖子的点赞数
+		// 9.3 给作者的「收到的点赞数」减去该帖子的点赞数
 		if err := user_stats_repo.
 			IncrementPostLikeReceived(ctx, authorID, -int64(stats.LikeCount)); err != nil {
 			errChan <- fmt.Errorf("decrement user received likes: %w", err)
@@ -232,16 +556,19 @@ func DeletePost(ctx context.Context, userID, postID int64) error {
 		}
 	}
 
-	// 4) delete post base (ownership enforced)
-	if err := post_base_repo.DeletePostBase(ctx, userID, postID); err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// upper layer (handler) can map this to 404 or "no permission"
-			return err
+	// 10) delete media on S3 (best-effort)
+	picture_upload_service.DeletePostImagesJSON(ctx, base.MediaUrls)
+
+	// 11) delete post base and its stats row for real
+	return DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := post_stats_repo.DeleteStatsTx(tx, postID); err != nil {
+			return fmt.Errorf("delete post stats: %w", err)
 		}
-		return fmt.Errorf("failed to delete post: %w", err)
-	}
-	// post_stats row is deleted by FK cascade
-	return nil
+		if err := post_base_repo.PurgePostBaseTx(tx, postID); err != nil {
+			return fmt.Errorf("failed to purge post: %w", err)
+		}
+		return nil
+	})
 }
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -253,15 +580,21 @@ func DeletePost(ctx context.Context, userID, postID int64) error {
 //   - post stats (view/like/fav/etc.)
 //   - school_name
 //   - IsLikedByUser / IsFavByUser for the given viewer
-//	- user_name
+//   - user_name
 //
 // Special behavior:
-//   - If viewerID > 0 and viewerID != authorID, view_count will be incremented by 1 (best-effort).
+//   - Unless the viewer is the author, a view is recorded: counted once per viewer (anonymous
+//     viewers, viewerID <= 0, per clientIP) within a window and written to view_count a few
+//     seconds later (see view_service). Internal callers that are not a read pass clientIP "".
+//   - Drafts / scheduled posts are only returned to the author (others get gorm.ErrRecordNotFound).
+//   - Same for posts whose visibility does not allow the viewer, and hidden posts (see reports.go)
+//     except for moderators.
 //   - If stats row is missing, a zero-valued stats object is used.
 func GetPost(
 	ctx context.Context,
 	postID int64,
 	viewerID int64,
+	clientIP string,
 ) (*domain.Post, error) {
 
 	// 1) load base (must exist)
@@ -270,10 +603,15 @@ func GetPost(
 		return nil, fmt.Errorf("failed to load post: %w", err)
 	}
 
-	// 2) auto increment view_count when viewer != author
-	if viewerID > 0 && viewerID != base.UserID {
-		// best-effort: update stats table
-		_ = post_stats_repo.IncrementView(ctx, postID, 1)
+	// drafts / scheduled, hidden and followers-only / school-only posts look like missing posts
+	// to viewers who may not see them
+	if err := visibility_service.CheckPostReadable(ctx, base, viewerID); err != nil {
+		return nil, err
+	}
+
+	// 2) count a view when viewer != author (deduplicated + buffered, see view_service)
+	if viewerID != base.UserID && (viewerID > 0 || clientIP != "") {
+		view_service.RecordView(postID, viewerID, clientIP)
 	}
 
 	// 3) reuse the list builder to load:
@@ -297,7 +635,7 @@ func GetPost(
 	return p, nil
 }
 
-func GetPostBase(	
+func GetPostBase(
 	ctx context.Context,
 	postID int64,
 ) (*domain.PostBase, error) {
@@ -309,166 +647,341 @@ func GetPostBase(
 	return base, nil
 }
 
+// GetPostRevisions returns earlier versions of a post (version ASC).
+// The current version is the post itself and is not included.
+// Returns gorm.ErrRecordNotFound if the post does not exist or viewerID may not see it (see GetPost).
+func GetPostRevisions(ctx context.Context, postID, viewerID int64) ([]domain.PostRevision, error) {
+	base, err := post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to load post: %w", err)
+	}
+	// same rule as GetPost: an old version is as private as the post
+	if err := visibility_service.CheckPostReadable(ctx, base, viewerID); err != nil {
+		return nil, err
+	}
+
+	revs, err := post_revision_repo.ListRevisionsByPostID(ctx, postID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	return revs, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // Recent Posts: Personal / School
 ///////////////////////////////////////////////////////////////////////////////
 
-// GetSchoolRecentPosts returns only PostBase (without stats/user flags).
+// PostPage is one page of a cursor-paginated feed.
+type PostPage struct {
+	Posts       []domain.Post
+	QuotedPosts map[int64]domain.Post
+	NextCursor  string // "" when no more data
+	HasMore     bool
+}
+
+// Time-ordered feeds (school / category / personal / following / tag):
+//   - ordered by (created_at DESC, id DESC)
+//   - cursorStr is the NextCursor of previous page (signed, opaque), "" for the first page
+//   - beforeStr is the legacy RFC3339 `before`, only used when cursorStr is empty
+
+// GetSchoolRecentPostBases returns only PostBase (without stats/user flags).
 func GetSchoolRecentPostBases(
 	ctx context.Context,
 	schoolID int64,
+	viewerID int64,
+	cursorStr string,
 	beforeStr string,
 	limit int,
 ) ([]domain.PostBase, error) {
 
-	before, err := parseBeforeTime(beforeStr)
+	pos, err := resolveFeedPosition(cursorStr, beforeStr)
 	if err != nil {
 		return nil, err
 	}
-	return post_base_repo.ListPostsBySchoolIDBefore(ctx, schoolID, before, limit)
+	return post_base_repo.ListPostsBySchoolIDBefore(ctx, loadPostViewer(ctx, viewerID), schoolID, pos.Before, pos.BeforeID, limit)
 }
 
-// GetSchoolRecentPostsWithStats returns []Post with stats / school name.
+// GetSchoolRecentPosts returns one page of school feed with stats / school name.
 // viewerID can be -1 if you don't need IsLikedByUser / IsFavByUser.
 func GetSchoolRecentPosts(
-    ctx context.Context,
-    schoolID int64,
-    viewerID int64,
-    beforeStr string,
-    limit int,
-) ([]domain.Post, map[int64]domain.Post, error) {
-
-    bases, err := GetSchoolRecentPostBases(ctx, schoolID, beforeStr, limit)
-    if err != nil {
-        return nil, nil, err
-    }
-    if len(bases) == 0 {
-        return []domain.Post{}, map[int64]domain.Post{}, nil
-    }
-
-    posts, err := buildPostLists(ctx, bases, viewerID)
-    if err != nil {
-        return nil, nil, fmt.Errorf("failed building posts: %w", err)
-    }
+	ctx context.Context,
+	schoolID int64,
+	viewerID int64,
+	cursorStr string,
+	beforeStr string,
+	limit int,
+) (*PostPage, error) {
 
-    quotedMap, err := getQuotedPostsByIDs(ctx, posts)
-    if err != nil {
-        return nil, nil, fmt.Errorf("failed building quoted posts: %w", err)
-    }
+	// 多取 1 条判断 hasMore
+	bases, err := GetSchoolRecentPostBases(ctx, schoolID, viewerID, cursorStr, beforeStr, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	return buildTimeFeedPage(ctx, bases, limit, viewerID)
+}
 
-    return posts, quotedMap, nil
+// ResolveCategoryID returns the category id given either an id or a key (id wins).
+// Category must exist in the cache.
+func ResolveCategoryID(categoryID int64, categoryKey string) (int64, error) {
+	if categoryID > 0 {
+		if _, err := category_repo.GetCategoryByIDInCache(categoryID); err != nil {
+			return 0, fmt.Errorf("category %d not found", categoryID)
+		}
+		return categoryID, nil
+	}
+	c, err := category_repo.GetCategoryByKeyInCache(categoryKey)
+	if err != nil {
+		return 0, fmt.Errorf("category %q not found", categoryKey)
+	}
+	return c.ID, nil
 }
 
+// GetCategoryRecentPosts returns posts of one board (category) within a school, newest first.
+func GetCategoryRecentPosts(
+	ctx context.Context,
+	schoolID int64,
+	categoryID int64,
+	viewerID int64,
+	cursorStr string,
+	beforeStr string,
+	limit int,
+) (*PostPage, error) {
+
+	pos, err := resolveFeedPosition(cursorStr, beforeStr)
+	if err != nil {
+		return nil, err
+	}
 
+	bases, err := post_base_repo.ListPostsBySchoolAndCategoryBefore(ctx, loadPostViewer(ctx, viewerID), schoolID, categoryID, pos.Before, pos.BeforeID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list category posts: %w", err)
+	}
+	return buildTimeFeedPage(ctx, bases, limit, viewerID)
+}
 
+// GetMentionedPosts returns posts that @mention userID, newest first (viewed by userID).
+func GetMentionedPosts(ctx context.Context, userID int64, cursorStr string, limit int) (*PostPage, error) {
+	pos, err := resolveFeedPosition(cursorStr, "")
+	if err != nil {
+		return nil, err
+	}
 
+	bases, err := post_base_repo.ListPostsMentioningUserBefore(ctx, loadPostViewer(ctx, userID), userID, pos.Before, pos.BeforeID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mentioned posts: %w", err)
+	}
+	return buildTimeFeedPage(ctx, bases, limit, userID)
+}
 
-// GetPersonalRecentPosts returns only PostBase for a user.
+// GetPersonalRecentPostBases returns only PostBase for a user.
 func GetPersonalRecentPostBases(
 	ctx context.Context,
 	userID int64,
+	viewerID int64,
+	cursorStr string,
 	beforeStr string,
 	limit int,
 ) ([]domain.PostBase, error) {
 
-	before, err := parseBeforeTime(beforeStr)
+	pos, err := resolveFeedPosition(cursorStr, beforeStr)
 	if err != nil {
-		return nil, fmt.Errorf("invalid time format for 'before': %v", err)
+		return nil, err
 	}
 
-	return post_base_repo.ListPostsByUserIDBefore(ctx, userID, before, limit)
+	return post_base_repo.ListPostsByUserIDBefore(ctx, loadPostViewer(ctx, viewerID), userID, pos.Before, pos.BeforeID, limit)
 }
 
-// GetPersonalRecentPostsWithStats returns:
+// GetPersonalRecentPosts returns one page of:
 //   - base fields
 //   - stats from post_stats
 //   - school_name
 //   - viewer's like/fav flags
+//
+// The first page starts with the user's pinned posts (on top of limit, see pins.go);
+// pinned posts never show up in the time-ordered part.
 func GetPersonalRecentPosts(
-    ctx context.Context,
-    userID int64,
-    viewerID int64,
-    beforeStr string,
-    limit int,
-) ([]domain.Post, map[int64]domain.Post, error) {
-
-    bases, err := GetPersonalRecentPostBases(ctx, userID, beforeStr, limit)
-    if err != nil {
-        return nil, nil, fmt.Errorf("failed to list posts: %v", err)
-    }
-    if len(bases) == 0 {
-        return []domain.Post{}, map[int64]domain.Post{}, nil
-    }
-
-    posts, err := buildPostLists(ctx, bases, viewerID)
-    if err != nil {
-        return nil, nil, fmt.Errorf("failed building posts: %w", err)
-    }
-
-    quotedMap, err := getQuotedPostsByIDs(ctx, posts)
-    if err != nil {
-        return nil, nil, fmt.Errorf("failed building quoted posts: %w", err)
-    }
-
-    return posts, quotedMap, nil
-}
-
-
-func getQuotedPostsByIDs(ctx context.Context, posts []domain.Post) (map[int64]domain.Post, error) {
-    // 返回结果：key = 被引用帖子的 ID（ReplyTo），value = 对应的完整 Post
-    result := make(map[int64]domain.Post)
-    if len(posts) == 0 {
-        return result, nil
-    }
-
-    // 1) 收集所有非空 ReplyTo，去重
-    replyIDSet := make(map[int64]struct{})
-    for _, p := range posts {
-        if p.ReplyTo != nil {
-            id := *p.ReplyTo
-            if id > 0 {
-                replyIDSet[id] = struct{}{}
-            }
-        }
-    }
-
-    // 如果这一页压根没有引用任何帖子，直接返回空 map
-    if len(replyIDSet) == 0 {
-        return result, nil
-    }
-
-    // 2) 按 ID 去 DB 拿 PostBase
-    bases := make([]domain.PostBase, 0, len(replyIDSet))
-    for replyID := range replyIDSet {
-        base, err := post_base_repo.GetPostBaseByID(ctx, replyID)
-        if err != nil {
-            if errors.Is(err, gorm.ErrRecordNotFound) {
-                // 被删了的原帖：跳过
-                continue
-            }
-            return nil, fmt.Errorf("failed to load quoted post %d: %w", replyID, err)
-        }
-        bases = append(bases, *base)
-    }
-
-    if len(bases) == 0 {
-        // 全部都找不到（被删光了），也算正常情况
-        return result, nil
-    }
-
-    quotedPosts, err := buildPostLists(ctx, bases, 0 /* viewerID=0，不需要 like/fav flags */)
-    if err != nil {
-        return nil, fmt.Errorf("failed building quoted posts: %w", err)
-    }
-
-    // 4) 写入 result：key = 帖子 ID
-    for _, qp := range quotedPosts {
-        result[qp.ID] = qp
-    }
-
-    return result, nil
+	ctx context.Context,
+	userID int64,
+	viewerID int64,
+	cursorStr string,
+	beforeStr string,
+	limit int,
+) (*PostPage, error) {
+
+	bases, err := GetPersonalRecentPostBases(ctx, userID, viewerID, cursorStr, beforeStr, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list posts: %w", err)
+	}
+	page, err := buildTimeFeedPage(ctx, bases, limit, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursorStr == "" && beforeStr == "" {
+		if err := prependPinnedPosts(ctx, page, userID, viewerID); err != nil {
+			return nil, err
+		}
+	}
+	return page, nil
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Recent Posts: Following
+///////////////////////////////////////////////////////////////////////////////
+
+// GetFollowingUsersRecentPostBases returns only PostBase written by users that viewerID follows.
+func GetFollowingUsersRecentPostBases(
+	ctx context.Context,
+	viewerID int64,
+	cursorStr string,
+	beforeStr string,
+	limit int,
+) ([]domain.PostBase, error) {
+
+	pos, err := resolveFeedPosition(cursorStr, beforeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	followeeIDs, err := user_follow_repo.ListFolloweeIDs(ctx, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list followees: %w", err)
+	}
+	if len(followeeIDs) == 0 {
+		return []domain.PostBase{}, nil
+	}
+
+	return post_base_repo.ListPostsByUserIDsBefore(ctx, loadPostViewer(ctx, viewerID), followeeIDs, pos.Before, pos.BeforeID, limit)
 }
 
+// GetFollowingUsersRecentPosts returns the "following" feed of viewerID:
+//   - recent posts of everyone the viewer follows
+//   - stats / school_name / user_name
+//   - viewer's like/fav flags
+//   - quoted posts map (same as school / personal feed)
+func GetFollowingUsersRecentPosts(
+	ctx context.Context,
+	viewerID int64,
+	cursorStr string,
+	beforeStr string,
+	limit int,
+) (*PostPage, error) {
+
+	if viewerID <= 0 {
+		return nil, fmt.Errorf("invalid viewer id")
+	}
+
+	bases, err := GetFollowingUsersRecentPostBases(ctx, viewerID, cursorStr, beforeStr, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	return buildTimeFeedPage(ctx, bases, limit, viewerID)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Hot Posts: School / All
+///////////////////////////////////////////////////////////////////////////////
+
+// GetHotPosts returns posts ordered by hot_score DESC, id DESC.
+//   - schoolID <= 0 means across all schools.
+//   - cursorStr is the NextCursor of previous page, "" for the first page.
+//
+// hot_score is recomputed in the background (hot_score_service), so there is no stable key to seek on.
+// The cursor is (snapshot, offset): the first page fixes the snapshot time and later pages only see
+// posts published before it, so new posts never shift the pages. Scores of those posts can still
+// change while the client pages, then a post may move across a page boundary (shown twice or not
+// at all); a refresh (empty cursor) starts a new snapshot.
+func GetHotPosts(
+	ctx context.Context,
+	schoolID int64,
+	viewerID int64,
+	cursorStr string,
+	limit int,
+) (*PostPage, error) {
+
+	snapshot, offset, err := decodeHotCursor(cursorStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// 多取 1 条判断 hasMore
+	bases, err := post_base_repo.ListHotPosts(ctx, loadPostViewer(ctx, viewerID), schoolID, snapshot, offset, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list hot posts: %w", err)
+	}
+
+	return buildPostPage(ctx, bases, limit, viewerID, func(domain.PostBase) string {
+		return encodeHotCursor(snapshot, offset+limit)
+	})
+}
+
+// getQuotedPostsByIDs loads the posts quoted (ReplyTo) by posts.
+//   - deleted / unpublished quoted posts are left out.
+//   - quoted posts viewerID cannot see come back as placeholders (Unavailable = true).
+func getQuotedPostsByIDs(ctx context.Context, posts []domain.Post, viewerID int64) (map[int64]domain.Post, error) {
+	// 返回结果：key = 被引用帖子的 ID（ReplyTo），value = 对应的完整 Post
+	result := make(map[int64]domain.Post)
+	if len(posts) == 0 {
+		return result, nil
+	}
+
+	// 1) 收集所有非空 ReplyTo，去重
+	replyIDSet := make(map[int64]struct{})
+	for _, p := range posts {
+		if p.ReplyTo != nil {
+			id := *p.ReplyTo
+			if id > 0 {
+				replyIDSet[id] = struct{}{}
+			}
+		}
+	}
+
+	// 如果这一页压根没有引用任何帖子，直接返回空 map
+	if len(replyIDSet) == 0 {
+		return result, nil
+	}
+
+	// 2) 按 ID 批量去 DB 拿 PostBase；被删了（或还没发布）的原帖不会返回
+	replyIDs := make([]int64, 0, len(replyIDSet))
+	for replyID := range replyIDSet {
+		replyIDs = append(replyIDs, replyID)
+	}
+	bases, err := post_base_repo.GetPublishedPostBasesByIDs(ctx, replyIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load quoted posts: %w", err)
+	}
+
+	if len(bases) == 0 {
+		// 全部都找不到（被删光了），也算正常情况
+		return result, nil
+	}
+
+	// 3) buildPostLists 会按 viewer 过滤掉看不到的帖子
+	quotedPosts, err := buildPostLists(ctx, bases, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed building quoted posts: %w", err)
+	}
+
+	// 4) 写入 result：key = 帖子 ID
+	for _, qp := range quotedPosts {
+		result[qp.ID] = qp
+	}
+
+	// 5) 看不到的原帖：只返回占位（id + visibility），前端显示“无法查看”
+	for _, b := range bases {
+		if _, ok := result[b.ID]; !ok {
+			result[b.ID] = domain.Post{
+				PostBase:    domain.PostBase{ID: b.ID, Visibility: b.Visibility},
+				Unavailable: true,
+			}
+		}
+	}
+
+	return result, nil
+}
 
 ///////////////////////////////////////////////////////////////////////////////
 // Like / Unlike / Favorite / Unfavorite
@@ -477,97 +990,116 @@ func getQuotedPostsByIDs(ctx context.Context, posts []domain.Post) (map[int64]do
 // LikePost lets a user like a post.
 //
 // Behavior:
-//   - Ensures post exists.
+//   - Ensures post exists and the user can see it.
 //   - If already liked, it's a no-op (idempotent).
-//   - If not liked, insert like row and increment like_count by 1 (best-effort).
-//   - There is a small race condition window, but an offline reconciliation
-//     job can correct like_count if necessary.
+//   - The post_likes row, post_stats.like_count and the author's post_like_received_count
+//     are written in one transaction: all of them change or none does.
+//   - Two concurrent likes by the same user: the insert is ON CONFLICT DO NOTHING, only the
+//     one that really inserted the row bumps the counters.
 func LikePost(ctx context.Context, userID, postID int64) error {
 	// Ensure post exists
-	if _, err := post_base_repo.GetPostBaseByID(ctx, postID); err != nil {
-		return fmt.Errorf("post not found: %w", err)
-	}
-
-	// Check if already liked
-	liked, err := post_like_repo.HasUserLiked(ctx, userID, postID)
+	base, err := post_base_repo.GetPublishedPostBaseByID(ctx, postID)
 	if err != nil {
-		return fmt.Errorf("failed to check like state: %w", err)
+		return fmt.Errorf("post not found: %w", err)
 	}
-	if liked {
-		return nil
+	if visible, err := canViewPost(ctx, base, userID); err != nil {
+		return err
+	} else if !visible {
+		return fmt.Errorf("post not found: %w", gorm.ErrRecordNotFound)
 	}
 
-	// Insert like
-	if err := post_like_repo.LikePost(ctx, userID, postID); err != nil {
+	changed := false
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		inserted, err := post_like_repo.LikePostTx(tx, userID, postID)
+		if err != nil || !inserted {
+			return err
+		}
+		if err := post_stats_repo.IncrementLikeTx(tx, postID, 1); err != nil {
+			return err
+		}
+		// 收到的赞记在帖子作者身上
+		if err := user_stats_repo.IncrementPostLikeReceivedTx(tx, base.UserID, 1); err != nil {
+			return err
+		}
+		changed = true
+		return nil
+	})
+	if err != nil {
 		return fmt.Errorf("failed to like post: %w", err)
 	}
 
-	// Increment like_count (best-effort)
-	_ = post_stats_repo.IncrementLike(ctx, postID, 1)
-
-
-	// Increment author's received-like count (best-effort)
-	_ = user_stats_repo.IncrementPostLikeReceived(ctx, int64(userID), 1)
-
+	if changed {
+		hot_score_service.Touch(postID)
+	}
 	return nil
 }
 
 // UnlikePost lets a user remove like from a post.
 // - If not liked, it's treated as success.
+// - Same transaction rules as LikePost.
 func UnlikePost(ctx context.Context, userID, postID int64) error {
 	// Ensure post exists
-	if _, err := post_base_repo.GetPostBaseByID(ctx, postID); err != nil {
+	base, err := post_base_repo.GetPostBaseByID(ctx, postID)
+	if err != nil {
 		return fmt.Errorf("post not found: %w", err)
 	}
 
-	// Check if liked
-	liked, err := post_like_repo.HasUserLiked(ctx, userID, postID)
-	if err != nil {
-		return fmt.Errorf("failed to check like state: %w", err)
-	}
-	if !liked {
+	changed := false
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		removed, err := post_like_repo.UnlikePostTx(tx, userID, postID)
+		if err != nil || !removed {
+			return err
+		}
+		if err := post_stats_repo.IncrementLikeTx(tx, postID, -1); err != nil {
+			return err
+		}
+		if err := user_stats_repo.IncrementPostLikeReceivedTx(tx, base.UserID, -1); err != nil {
+			return err
+		}
+		changed = true
 		return nil
-	}
-
-	// Remove like
-	if err := post_like_repo.UnlikePost(ctx, userID, postID); err != nil {
+	})
+	if err != nil {
 		return fmt.Errorf("failed to unlike post: %w", err)
 	}
 
-	// Decrement like_count (best-effort)
-	_ = post_stats_repo.IncrementLike(ctx, postID, -1)
-
-	// Decrement author's received-like count (best-effort)
-	_ = user_stats_repo.IncrementPostLikeReceived(ctx, int64(userID), -1)
-
+	if changed {
+		hot_score_service.Touch(postID)
+	}
 	return nil
 }
 
 // FavoritePost lets a user favorite a post.
 // - Idempotent: multiple calls will keep only one row in post_favorites.
+// - The post_favorites row and fav_count change in one transaction.
 func FavoritePost(ctx context.Context, userID, postID int64) error {
 	// Ensure post exists
-	if _, err := post_base_repo.GetPostBaseByID(ctx, postID); err != nil {
-		return fmt.Errorf("post not found: %w", err)
-	}
-
-	// Check if already favorited
-	faved, err := post_fav_repo.HasUserFavorited(ctx, userID, postID)
+	base, err := post_base_repo.GetPublishedPostBaseByID(ctx, postID)
 	if err != nil {
-		return fmt.Errorf("failed to check favorite state: %w", err)
+		return fmt.Errorf("post not found: %w", err)
 	}
-	if faved {
-		return nil
+	if visible, err := canViewPost(ctx, base, userID); err != nil {
+		return err
+	} else if !visible {
+		return fmt.Errorf("post not found: %w", gorm.ErrRecordNotFound)
 	}
 
-	// Insert favorite
-	if err := post_fav_repo.FavoritePost(ctx, userID, postID); err != nil {
+	changed := false
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		inserted, err := post_fav_repo.FavoritePostTx(tx, userID, postID)
+		if err != nil || !inserted {
+			return err
+		}
+		changed = true
+		return post_stats_repo.IncrementFavTx(tx, postID, 1)
+	})
+	if err != nil {
 		return fmt.Errorf("failed to favorite post: %w", err)
 	}
 
-	// Increment fav_count (best-effort)
-	_ = post_stats_repo.IncrementFav(ctx, postID, 1)
-
+	if changed {
+		hot_score_service.Touch(postID)
+	}
 	return nil
 }
 
@@ -579,24 +1111,141 @@ func UnfavoritePost(ctx context.Context, userID, postID int64) error {
 		return fmt.Errorf("post not found: %w", err)
 	}
 
-	// Check if favorited
-	faved, err := post_fav_repo.HasUserFavorited(ctx, userID, postID)
+	changed := false
+	err := DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		removed, err := post_fav_repo.UnfavoritePostTx(tx, userID, postID)
+		if err != nil || !removed {
+			return err
+		}
+		changed = true
+		return post_stats_repo.IncrementFavTx(tx, postID, -1)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to check favorite state: %w", err)
+		return fmt.Errorf("failed to unfavorite post: %w", err)
 	}
-	if !faved {
-		return nil
+
+	if changed {
+		hot_score_service.Touch(postID)
 	}
+	return nil
+}
 
-	// Remove favorite
-	if err := post_fav_repo.UnfavoritePost(ctx, userID, postID); err != nil {
-		return fmt.Errorf("failed to unfavorite post: %w", err)
+///////////////////////////////////////////////////////////////////////////////
+// Tag Posts
+///////////////////////////////////////////////////////////////////////////////
+
+// GetTagRecentPosts returns one page of posts of a tag, newest first.
+//   - tagName is normalized first ("#Exam" == "exam").
+//   - schoolID <= 0 means all schools.
+//   - A tag that was never used returns an empty list, not an error.
+func GetTagRecentPosts(
+	ctx context.Context,
+	tagName string,
+	schoolID int64,
+	viewerID int64,
+	cursorStr string,
+	beforeStr string,
+	limit int,
+) (*PostPage, error) {
+
+	name := tag_service.NormalizeTag(tagName)
+	if name == "" {
+		return nil, fmt.Errorf("invalid tag name")
+	}
+
+	pos, err := resolveFeedPosition(cursorStr, beforeStr)
+	if err != nil {
+		return nil, err
 	}
 
-	// Decrement fav_count (best-effort)
-	_ = post_stats_repo.IncrementFav(ctx, postID, -1)
+	tag, err := tag_repo.GetTagByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return buildTimeFeedPage(ctx, nil, limit, viewerID)
+		}
+		return nil, fmt.Errorf("failed to load tag: %w", err)
+	}
 
-	return nil
+	bases, err := post_base_repo.ListPostsByTagIDBefore(ctx, loadPostViewer(ctx, viewerID), tag.ID, schoolID, pos.Before, pos.BeforeID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tag posts: %w", err)
+	}
+	return buildTimeFeedPage(ctx, bases, limit, viewerID)
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Search
+///////////////////////////////////////////////////////////////////////////////
+
+// ErrInvalidSearch is returned (wrapped) when the search params are invalid, e.g. bad time range.
+var ErrInvalidSearch = errors.New("invalid search")
+
+// SearchPostsParams is what the client can search / filter by.
+// Zero value of each field means not filtered.
+type SearchPostsParams struct {
+	Query      string
+	SchoolID   int64
+	CategoryID int64
+	UserID     int64
+	Tag        string
+	StartTime  string // RFC3339, inclusive
+	EndTime    string // RFC3339, exclusive
+}
+
+// SearchPosts runs full-text search with filters, hydrated the same way as other feeds.
+//   - Query is matched against title / tags / content, ranked by relevance.
+//   - cursorStr is the NextCursor of previous page, "" for the first page.
+//   - ErrInvalidSearch / cursor.ErrInvalidCursor for bad input, anything else is a server error.
+func SearchPosts(
+	ctx context.Context,
+	params SearchPostsParams,
+	viewerID int64,
+	cursorStr string,
+	limit int,
+) (*PostPage, error) {
+
+	filter := post_base_repo.PostSearchFilter{
+		Query:      strings.TrimSpace(params.Query),
+		SchoolID:   params.SchoolID,
+		CategoryID: params.CategoryID,
+		UserID:     params.UserID,
+	}
+	if tag := strings.TrimSpace(params.Tag); tag != "" {
+		// 和 post_tags 里存的形式一致，#Go / go 搜到的是同一批帖子
+		if filter.Tag = tag_service.NormalizeTag(tag); filter.Tag == "" {
+			return nil, fmt.Errorf("%w: invalid tag", ErrInvalidSearch)
+		}
+	}
+
+	var err error
+	if params.StartTime != "" {
+		if filter.Since, err = parseBeforeTime(params.StartTime); err != nil {
+			return nil, fmt.Errorf("%w: invalid start_time", ErrInvalidSearch)
+		}
+	}
+	if params.EndTime != "" {
+		if filter.Until, err = parseBeforeTime(params.EndTime); err != nil {
+			return nil, fmt.Errorf("%w: invalid end_time", ErrInvalidSearch)
+		}
+	}
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return nil, fmt.Errorf("%w: start_time must be before end_time", ErrInvalidSearch)
+	}
+
+	offset, err := decodeOffsetCursor(cursorStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// 多取 1 条判断 hasMore
+	bases, err := post_base_repo.SearchPosts(ctx, loadPostViewer(ctx, viewerID), filter, offset, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search posts: %w", err)
+	}
+
+	return buildPostPage(ctx, bases, limit, viewerID, func(domain.PostBase) string {
+		return encodeOffsetCursor(offset + limit)
+	})
 }
 
 ///////////////////////////////////////////////////////////////////////////////
@@ -618,28 +1267,169 @@ func parseBeforeTime(beforeStr string) (time.Time, error) {
 	return time.Parse(time.RFC3339, beforeStr)
 }
 
+// feedPosition is where a page of a time-ordered feed starts (exclusive).
+type feedPosition struct {
+	Before   time.Time
+	BeforeID int64 // <= 0 means only "created_at < Before"
+}
+
+// ErrInvalidBefore is returned (wrapped) when the legacy `before` param is not RFC3339.
+var ErrInvalidBefore = errors.New("invalid time format for 'before'")
+
+// resolveFeedPosition: signed cursor wins; otherwise fall back to legacy `before` (now if empty).
+// Bad input gives cursor.ErrInvalidCursor / ErrInvalidBefore.
+func resolveFeedPosition(cursorStr, beforeStr string) (feedPosition, error) {
+	if cursorStr != "" {
+		v, err := cursor.Decode(cursorSecret(), timeCursorKind, cursorStr, 2)
+		if err != nil {
+			return feedPosition{}, err
+		}
+		return feedPosition{Before: time.Unix(0, v[0]), BeforeID: v[1]}, nil
+	}
+
+	before, err := parseBeforeTime(beforeStr)
+	if err != nil {
+		return feedPosition{}, fmt.Errorf("%w: %v", ErrInvalidBefore, err)
+	}
+	return feedPosition{Before: before}, nil
+}
+
+// Cursor kinds, so that a cursor of one kind of feed is rejected by another.
+const (
+	timeCursorKind   = "time"
+	hotCursorKind    = "hot_snapshot" // (snapshot, offset); old (score, id) "hot" cursors are rejected
+	offsetCursorKind = "offset"
+)
+
+// cursorSecret signs feed cursors. Reuses the JWT secret, cursor kinds keep the payloads apart from JWTs.
+func cursorSecret() []byte {
+	return []byte(config.GetGeneralConfig().JWT_Secret_Key)
+}
+
+func encodeTimeCursor(createdAt time.Time, postID int64) string {
+	return cursor.Encode(cursorSecret(), timeCursorKind, createdAt.UnixNano(), postID)
+}
+
+// encodeHotCursor / decodeHotCursor: hot feed cursor is (snapshot time, offset), see GetHotPosts.
+// An empty cursor starts a new snapshot now.
+func encodeHotCursor(snapshot time.Time, offset int) string {
+	return cursor.Encode(cursorSecret(), hotCursorKind, snapshot.UnixNano(), int64(offset))
+}
+
+func decodeHotCursor(cursorStr string) (snapshot time.Time, offset int, err error) {
+	if cursorStr == "" {
+		return time.Now(), 0, nil
+	}
+	v, err := cursor.Decode(cursorSecret(), hotCursorKind, cursorStr, 2)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	if v[1] < 0 {
+		return time.Time{}, 0, cursor.ErrInvalidCursor
+	}
+	return time.Unix(0, v[0]), int(v[1]), nil
+}
+
+// encodeOffsetCursor / decodeOffsetCursor: search cursor is the offset.
+// Search results are ranked, so there is no stable key to seek on.
+func encodeOffsetCursor(offset int) string {
+	return cursor.Encode(cursorSecret(), offsetCursorKind, int64(offset))
+}
+
+func decodeOffsetCursor(cursorStr string) (int, error) {
+	if cursorStr == "" {
+		return 0, nil
+	}
+	v, err := cursor.Decode(cursorSecret(), offsetCursorKind, cursorStr, 1)
+	if err != nil {
+		return 0, err
+	}
+	if v[0] < 0 {
+		return 0, cursor.ErrInvalidCursor
+	}
+	return int(v[0]), nil
+}
+
+// buildPostPage turns up to limit+1 bases into one page:
+//   - the extra row only tells whether there is a next page
+//   - nextCursor is called with the last fetched base of the page when there is a next page;
+//     not the last returned post, since rows the viewer can't see are dropped (possibly all of them)
+func buildPostPage(
+	ctx context.Context,
+	bases []domain.PostBase,
+	limit int,
+	viewerID int64,
+	nextCursor func(last domain.PostBase) string,
+) (*PostPage, error) {
+
+	hasMore := false
+	if len(bases) > limit {
+		bases = bases[:limit]
+		hasMore = true
+	}
+	if len(bases) == 0 {
+		return &PostPage{
+			Posts:       []domain.Post{},
+			QuotedPosts: map[int64]domain.Post{},
+		}, nil
+	}
+
+	posts, err := buildPostLists(ctx, bases, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed building posts: %w", err)
+	}
+
+	quotedMap, err := getQuotedPostsByIDs(ctx, posts, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed building quoted posts: %w", err)
+	}
+
+	page := &PostPage{
+		Posts:       posts,
+		QuotedPosts: quotedMap,
+		HasMore:     hasMore,
+	}
+	if hasMore {
+		page.NextCursor = nextCursor(bases[len(bases)-1])
+	}
+	return page, nil
+}
+
+// buildTimeFeedPage is buildPostPage for feeds ordered by (created_at DESC, id DESC).
+func buildTimeFeedPage(ctx context.Context, bases []domain.PostBase, limit int, viewerID int64) (*PostPage, error) {
+	return buildPostPage(ctx, bases, limit, viewerID, func(last domain.PostBase) string {
+		return encodeTimeCursor(last.CreatedAt, last.ID)
+	})
+}
 
 // buildPostLists:
 //   - Input:  []PostBase（已经按时间或其他方式分页好）
 //   - Output: []Post with:
-//       * base fields (PostBase)
-//       * aggregated stats from post_stats（批量查询）
-//       * school_name      （从学校缓存）
-//       * category_name    （从板块缓存）
-//       * user_name + avatar（批量查用户表）
-//       * IsLikedByUser / IsFavByUser for given viewer（批量查 like/fav）
+//   - base fields (PostBase)
+//   - aggregated stats from post_stats（批量查询）
+//   - school_name      （从学校缓存）
+//   - category_name    （从板块缓存）
+//   - user_name + avatar（批量查用户表）
+//   - IsLikedByUser / IsFavByUser for given viewer（批量查 like/fav）
 //
 // Implementation details:
 //   - Stats 使用 GetStatsByPostIDs 一次性批量查询，避免 N 次 DB round-trip。
 //   - Schools / Categories 从内存缓存获取（school_repo / category_repo）。
 //   - Users 使用 GetUsersByIDs 批量查询 DB。
 //   - Viewer interactions 使用 GetUserLikedPostIDs / GetUserFavoritedPostIDs 批量查询。
+//   - viewer 看不到的帖子（visibility）会被直接过滤掉，所以返回的条数可能少于输入。
+//   - 带投票的帖子会附上 poll（结果 + viewer 自己投了哪些）。
+//   - mentions（@username 的位置）从 post_mentions 批量查询。
 //   - 所有外部依赖通过 goroutine 并行拉取，用 WaitGroup + errChan 同步错误。
 func buildPostLists(
 	ctx context.Context,
 	bases []domain.PostBase,
 	viewerID int64,
 ) ([]domain.Post, error) {
+	bases, err := filterVisiblePosts(ctx, bases, viewerID)
+	if err != nil {
+		return nil, err
+	}
 	if len(bases) == 0 {
 		return []domain.Post{}, nil
 	}
@@ -686,10 +1476,12 @@ func buildPostLists(
 		userMap     map[int64]*domain.User
 		likedSet    map[int64]bool
 		favSet      map[int64]bool
+		pollMap     map[int64]*domain.PollView
+		mentionMap  map[int64][]domain.PostMention
 	)
 
 	var wg sync.WaitGroup
-	errChan := make(chan error, 6)
+	errChan := make(chan error, 8)
 
 	// 1) batch stats
 	wg.Add(1)
@@ -779,6 +1571,30 @@ func buildPostLists(
 		favSet = m
 	}()
 
+	// 7) polls + viewer's votes
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		m, err := loadPollViews(ctx, postIDs, viewerID)
+		if err != nil {
+			errChan <- fmt.Errorf("get polls: %w", err)
+			return
+		}
+		pollMap = m
+	}()
+
+	// 8) mentions
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		m, err := mention_service.GetMentionsByPostIDs(ctx, postIDs)
+		if err != nil {
+			errChan <- fmt.Errorf("get mentions: %w", err)
+			return
+		}
+		mentionMap = m
+	}()
+
 	wg.Wait()
 	close(errChan)
 	for err := range errChan {
@@ -831,6 +1647,8 @@ func buildPostLists(
 			UserAvatarUrl: userAvatarURL,
 			IsLikedByUser: likedSet[b.ID],
 			IsFavByUser:   favSet[b.ID],
+			Poll:          pollMap[b.ID],
+			Mentions:      mentionMap[b.ID],
 		})
 	}
 	return res, nil
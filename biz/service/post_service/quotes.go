@@ -0,0 +1,56 @@
+package post_service
+
+import (
+	"context"
+	"fmt"
+
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_stats_repo"
+	"zetian-personal-website-hertz/biz/service/hot_score_service"
+
+	"gorm.io/gorm"
+)
+
+/*
+Quotes
+------
+A post quotes another one through ReplyTo. The quoted post keeps a quote count in
+post_stats.share_count: +1 when a quoting post is published or restored from the trash,
+-1 when it is moved to the trash. Drafts never count.
+*/
+
+// adjustQuoteCount moves share_count of the post quoted by base by delta (best-effort).
+func adjustQuoteCount(ctx context.Context, base domain.PostBase, delta int32) {
+	if base.ReplyTo == nil || base.Status != domain.PostStatusPublished {
+		return
+	}
+	_ = post_stats_repo.IncrementShare(ctx, *base.ReplyTo, delta)
+	hot_score_service.Touch(*base.ReplyTo)
+}
+
+// GetPostQuotes returns posts that quote postID, newest first.
+// Returns gorm.ErrRecordNotFound if postID does not exist or viewerID can't see it.
+func GetPostQuotes(ctx context.Context, postID, viewerID int64, cursorStr string, limit int) (*PostPage, error) {
+	base, err := post_base_repo.GetPublishedPostBaseByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if visible, err := canViewPost(ctx, base, viewerID); err != nil {
+		return nil, err
+	} else if !visible {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	pos, err := resolveFeedPosition(cursorStr, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// 多取 1 条判断 hasMore
+	bases, err := post_base_repo.ListQuotesOfPostBefore(ctx, loadPostViewer(ctx, viewerID), postID, pos.Before, pos.BeforeID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quotes: %w", err)
+	}
+	return buildTimeFeedPage(ctx, bases, limit, viewerID)
+}
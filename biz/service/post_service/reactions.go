@@ -0,0 +1,144 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/pkg/cursor"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_like_repo"
+	"zetian-personal-website-hertz/biz/repository/user_repo"
+	"zetian-personal-website-hertz/biz/service/user_service"
+
+	"gorm.io/gorm"
+)
+
+// Lists of posts a user favorited / liked, ordered by the time of the fav / like
+// (not the post's created_at), so cursors carry (reacted_at, post_id).
+const (
+	favCursorKind    = "fav"
+	likeCursorKind   = "like"
+	likersCursorKind = "likers"
+)
+
+var ErrLikedPostsHidden = errors.New("this user's liked posts are private")
+
+// GetFavoritedPosts returns posts userID favorited, latest favorite first. Only for the owner.
+func GetFavoritedPosts(ctx context.Context, userID int64, cursorStr string, limit int) (*PostPage, error) {
+	before, beforeID, err := decodeReactionCursor(favCursorKind, cursorStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// 多取 1 条判断 hasMore
+	rows, err := post_base_repo.ListFavoritedPostsBefore(ctx, loadPostViewer(ctx, userID), userID, before, beforeID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list favorited posts: %w", err)
+	}
+	return buildReactedPostPage(ctx, favCursorKind, rows, limit, userID)
+}
+
+// GetLikedPosts returns posts ownerID liked, latest like first, as seen by viewerID.
+//   - Other users only get the list if ownerID turned on show_liked_posts, else ErrLikedPostsHidden.
+//   - Returns gorm.ErrRecordNotFound if ownerID does not exist.
+func GetLikedPosts(ctx context.Context, ownerID, viewerID int64, cursorStr string, limit int) (*PostPage, error) {
+	if ownerID != viewerID {
+		owner, err := user_repo.GetUserByID(ctx, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		if !owner.ShowLikedPosts {
+			return nil, ErrLikedPostsHidden
+		}
+	}
+
+	before, beforeID, err := decodeReactionCursor(likeCursorKind, cursorStr)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := post_base_repo.ListLikedPostsBefore(ctx, loadPostViewer(ctx, viewerID), ownerID, before, beforeID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list liked posts: %w", err)
+	}
+	return buildReactedPostPage(ctx, likeCursorKind, rows, limit, viewerID)
+}
+
+func buildReactedPostPage(ctx context.Context, kind string, rows []post_base_repo.ReactedPostBase, limit int, viewerID int64) (*PostPage, error) {
+	bases := make([]domain.PostBase, 0, len(rows))
+	reactedAt := make(map[int64]time.Time, len(rows))
+	for _, r := range rows {
+		bases = append(bases, r.PostBase)
+		reactedAt[r.ID] = r.ReactedAt
+	}
+
+	return buildPostPage(ctx, bases, limit, viewerID, func(last domain.PostBase) string {
+		return cursor.Encode(cursorSecret(), kind, reactedAt[last.ID].UnixNano(), last.ID)
+	})
+}
+
+// decodeReactionCursor returns (reacted_at, post_id) of the last row of previous page; now / 0 for the first page.
+func decodeReactionCursor(kind, cursorStr string) (time.Time, int64, error) {
+	if cursorStr == "" {
+		return time.Now(), 0, nil
+	}
+	v, err := cursor.Decode(cursorSecret(), kind, cursorStr, 2)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	return time.Unix(0, v[0]), v[1], nil
+}
+
+// LikerPage is one page of users who liked a post.
+type LikerPage struct {
+	Users      []domain.SimpleUserProfile
+	NextCursor string // "" when no more data
+	HasMore    bool
+}
+
+// GetPostLikers returns users who liked postID, latest like first, with viewer-relative follow flags.
+// Returns gorm.ErrRecordNotFound if the post does not exist or viewerID can't see it.
+func GetPostLikers(ctx context.Context, postID, viewerID int64, cursorStr string, limit int) (*LikerPage, error) {
+	base, err := post_base_repo.GetPublishedPostBaseByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if visible, err := canViewPost(ctx, base, viewerID); err != nil {
+		return nil, err
+	} else if !visible {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	before, beforeUserID, err := decodeReactionCursor(likersCursorKind, cursorStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// 多取 1 条判断 hasMore
+	likes, err := post_like_repo.ListLikersBefore(ctx, postID, before, beforeUserID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list likers: %w", err)
+	}
+
+	page := &LikerPage{}
+	if len(likes) > limit {
+		likes = likes[:limit]
+		page.HasMore = true
+	}
+	if page.HasMore {
+		last := likes[len(likes)-1]
+		page.NextCursor = cursor.Encode(cursorSecret(), likersCursorKind, last.CreatedAt.UnixNano(), last.UserID)
+	}
+
+	ids := make([]int64, 0, len(likes))
+	for _, l := range likes {
+		ids = append(ids, l.UserID)
+	}
+	if page.Users, err = user_service.GetSimpleUserProfiles(ctx, viewerID, ids); err != nil {
+		return nil, fmt.Errorf("failed to load likers: %w", err)
+	}
+	return page, nil
+}
@@ -0,0 +1,363 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"zetian-personal-website-hertz/biz/config"
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/pkg/cursor"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_report_repo"
+	"zetian-personal-website-hertz/biz/service/tag_service"
+	"zetian-personal-website-hertz/biz/service/visibility_service"
+
+	"gorm.io/gorm"
+)
+
+/*
+Reports & moderation
+--------------------
+Users report posts (one report per user per post, with a reason). When a post collects
+PostReportHideThreshold pending reports it is hidden automatically: it drops out of every
+feed / tag / search and only its author (and moderators) can still open it.
+
+Moderators (config moderator_user_ids) work through the queue of posts with pending reports
+and resolve all of them at once with one action:
+  - dismiss: reports were wrong, the post is shown again;
+  - hide:    the post stays hidden;
+  - delete:  the post is hidden and removed: it goes to the trash (purged later as usual),
+             but does not show up in the author's trash and can't be restored;
+  - warn:    the post is left as it is, the author gets a warning on record.
+Every action (including auto hides, moderator_id = 0) is written to moderation_actions.
+Authors see the actions on their own posts (with the note, without the moderator) through
+GetMyModerationActions, that is how a warning reaches them.
+*/
+
+const (
+	defaultReportHideThreshold = 5
+	maxReportDetailLength      = 500
+
+	reportsCursorKind          = "reports"
+	moderationActionCursorKind = "moderation_actions"
+	myModerationCursorKind     = "my_moderation_actions"
+)
+
+var (
+	ErrInvalidReportReason = errors.New("invalid report reason")
+	ErrCannotReportOwnPost = errors.New("you cannot report your own post")
+	ErrNotModerator        = errors.New("moderator only")
+	ErrInvalidModeration   = errors.New("invalid moderation action")
+)
+
+func reportHideThreshold() int64 {
+	n := config.GetSpecificConfig().PostReportHideThreshold
+	if n <= 0 {
+		n = defaultReportHideThreshold
+	}
+	return int64(n)
+}
+
+// IsModerator reports whether userID may handle reports.
+func IsModerator(userID int64) bool {
+	return visibility_service.IsModerator(userID)
+}
+
+// ReportPost records that userID reports postID.
+//   - Reporting the same post twice is a no-op (still success).
+//   - Returns gorm.ErrRecordNotFound if the post does not exist / is not published / the user can't see it.
+//   - Hides the post once pending reports reach the threshold.
+func ReportPost(ctx context.Context, userID, postID int64, reason, detail string) error {
+	if !domain.IsValidReportReason(reason) {
+		return ErrInvalidReportReason
+	}
+	detail = strings.TrimSpace(detail)
+	if utf8.RuneCountInString(detail) > maxReportDetailLength {
+		return fmt.Errorf("%w: detail must be at most %d characters", ErrInvalidReportReason, maxReportDetailLength)
+	}
+
+	base, err := post_base_repo.GetPublishedPostBaseByID(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if base.UserID == userID {
+		return ErrCannotReportOwnPost
+	}
+	if visible, err := canViewPost(ctx, base, userID); err != nil {
+		return err
+	} else if !visible {
+		return gorm.ErrRecordNotFound
+	}
+
+	inserted, err := post_report_repo.CreateReport(ctx, &domain.PostReport{
+		PostID:     postID,
+		ReporterID: userID,
+		Reason:     reason,
+		Detail:     detail,
+		Status:     domain.ReportStatusPending,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to report post: %w", err)
+	}
+	if !inserted {
+		return nil
+	}
+
+	pending, err := post_report_repo.CountPendingReports(ctx, postID)
+	if err != nil {
+		return fmt.Errorf("failed to count reports: %w", err)
+	}
+	if pending < reportHideThreshold() {
+		return nil
+	}
+	return autoHidePost(ctx, *base, pending)
+}
+
+// autoHidePost hides a post that reached the report threshold. Only the request that
+// actually flips the flag writes the audit row.
+func autoHidePost(ctx context.Context, base domain.PostBase, pending int64) error {
+	err := DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		changed, err := post_base_repo.SetPostHiddenTx(tx, base.ID, true)
+		if err != nil || !changed {
+			return err
+		}
+		return post_report_repo.CreateModerationActionTx(tx, &domain.ModerationAction{
+			ModeratorID:  0,
+			PostID:       base.ID,
+			TargetUserID: base.UserID,
+			Action:       domain.ModerationAutoHide,
+			Note:         fmt.Sprintf("%d pending reports", pending),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to hide post: %w", err)
+	}
+
+	// hidden posts don't count for tags
+	if err := tag_service.DeletePostTags(ctx, base.ID); err != nil {
+		return fmt.Errorf("delete post tags: %w", err)
+	}
+	return nil
+}
+
+// ReportedPostPage is a page of the moderation queue.
+type ReportedPostPage struct {
+	Posts      []domain.ReportedPost
+	NextCursor string
+	HasMore    bool
+}
+
+// GetPendingReports returns posts with pending reports, the longest waiting first. Moderators only.
+func GetPendingReports(ctx context.Context, moderatorID int64, cursorStr string, limit int) (*ReportedPostPage, error) {
+	if !IsModerator(moderatorID) {
+		return nil, ErrNotModerator
+	}
+
+	var after time.Time
+	var afterID int64
+	if cursorStr != "" {
+		v, err := cursor.Decode(cursorSecret(), reportsCursorKind, cursorStr, 2)
+		if err != nil {
+			return nil, err
+		}
+		after, afterID = time.Unix(0, v[0]), v[1]
+	}
+
+	// 多取 1 条判断 hasMore
+	rows, err := post_report_repo.ListPendingReportedPosts(ctx, after, afterID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reported posts: %w", err)
+	}
+	page := &ReportedPostPage{Posts: []domain.ReportedPost{}}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		page.HasMore = true
+		page.NextCursor = cursor.Encode(cursorSecret(), reportsCursorKind, last.FirstReportedAt.UnixNano(), last.PostID)
+	}
+	if len(rows) == 0 {
+		return page, nil
+	}
+
+	ids := make([]int64, 0, len(rows))
+	for _, r := range rows {
+		ids = append(ids, r.PostID)
+	}
+	bases, err := post_base_repo.GetPostBasesByIDsUnscoped(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load reported posts: %w", err)
+	}
+	baseByID := make(map[int64]domain.PostBase, len(bases))
+	for _, b := range bases {
+		baseByID[b.ID] = b
+	}
+	reasons, err := post_report_repo.CountPendingReasonsByPostIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count report reasons: %w", err)
+	}
+
+	for _, r := range rows {
+		base, ok := baseByID[r.PostID]
+		if !ok {
+			// purged between the two queries
+			continue
+		}
+		page.Posts = append(page.Posts, domain.ReportedPost{
+			Base:            base,
+			PendingReports:  r.PendingReports,
+			Reasons:         reasons[r.PostID],
+			FirstReportedAt: r.FirstReportedAt,
+		})
+	}
+	return page, nil
+}
+
+// ResolvePostReports applies action (domain.Moderation*, auto_hide excluded) to a reported post
+// and closes all its pending reports. Returns how many reports were closed.
+//   - ErrNotModerator / ErrInvalidModeration for rejected calls.
+//   - gorm.ErrRecordNotFound if the post does not exist (trashed posts can still be resolved).
+func ResolvePostReports(ctx context.Context, moderatorID, postID int64, action, note string) (int32, error) {
+	if !IsModerator(moderatorID) {
+		return 0, ErrNotModerator
+	}
+	status := domain.ReportStatusActioned
+	switch action {
+	case domain.ModerationDismiss:
+		status = domain.ReportStatusDismissed
+	case domain.ModerationHide, domain.ModerationDelete, domain.ModerationWarn:
+	default:
+		return 0, ErrInvalidModeration
+	}
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > maxReportDetailLength {
+		return 0, fmt.Errorf("%w: note must be at most %d characters", ErrInvalidModeration, maxReportDetailLength)
+	}
+
+	base, err := post_base_repo.GetPostBaseByIDUnscoped(ctx, postID)
+	if err != nil {
+		return 0, err
+	}
+
+	var resolved int64
+	var hiddenChanged bool
+	err = DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if resolved, err = post_report_repo.ResolveReportsTx(tx, postID, status, moderatorID, time.Now()); err != nil {
+			return err
+		}
+		switch action {
+		case domain.ModerationDismiss:
+			hiddenChanged, err = post_base_repo.SetPostHiddenTx(tx, postID, false)
+		case domain.ModerationHide, domain.ModerationDelete:
+			hiddenChanged, err = post_base_repo.SetPostHiddenTx(tx, postID, true)
+		}
+		if err != nil {
+			return err
+		}
+		if action == domain.ModerationDelete {
+			if err := post_base_repo.RemovePostByModeratorTx(tx, postID); err != nil {
+				return err
+			}
+		}
+		return post_report_repo.CreateModerationActionTx(tx, &domain.ModerationAction{
+			ModeratorID:  moderatorID,
+			PostID:       postID,
+			TargetUserID: base.UserID,
+			Action:       action,
+			Note:         note,
+		})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve reports: %w", err)
+	}
+
+	// post_tags follow the hidden flag (trashed posts have none anyway)
+	if hiddenChanged && !base.DeletedAt.Valid {
+		if action == domain.ModerationDismiss {
+			base.Hidden = false
+			if base.IsListed() {
+				err = tag_service.SyncPostTagsFromBase(ctx, *base)
+			}
+		} else {
+			err = tag_service.DeletePostTags(ctx, postID)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to sync post tags: %w", err)
+		}
+	}
+
+	// same side effects as DeletePost for a post that was not in the trash yet (tags are gone with the hide)
+	if action == domain.ModerationDelete && !base.DeletedAt.Valid {
+		adjustQuoteCount(ctx, *base, -1)
+	}
+	return int32(resolved), nil
+}
+
+type ModerationActionPage struct {
+	Actions    []domain.ModerationAction
+	NextCursor string
+	HasMore    bool
+}
+
+// GetModerationActions returns the audit trail, latest first (postID > 0 keeps one post). Moderators only.
+// cursorStr is NextCursor of the previous page, "" for the first page.
+func GetModerationActions(ctx context.Context, moderatorID, postID int64, cursorStr string, limit int) (*ModerationActionPage, error) {
+	if !IsModerator(moderatorID) {
+		return nil, ErrNotModerator
+	}
+
+	beforeID, err := decodeModerationCursor(moderationActionCursorKind, cursorStr)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := post_report_repo.ListModerationActions(ctx, postID, beforeID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list moderation actions: %w", err)
+	}
+	return buildModerationActionPage(moderationActionCursorKind, actions, limit), nil
+}
+
+// GetMyModerationActions returns the actions taken on posts of userID, latest first, so authors
+// learn about warnings / hides / removals. ModeratorID is cleared: who acted is not disclosed.
+func GetMyModerationActions(ctx context.Context, userID int64, cursorStr string, limit int) (*ModerationActionPage, error) {
+	beforeID, err := decodeModerationCursor(myModerationCursorKind, cursorStr)
+	if err != nil {
+		return nil, err
+	}
+	actions, err := post_report_repo.ListModerationActionsByTargetUser(ctx, userID, beforeID, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list moderation actions: %w", err)
+	}
+	for i := range actions {
+		actions[i].ModeratorID = 0
+	}
+	return buildModerationActionPage(myModerationCursorKind, actions, limit), nil
+}
+
+// decodeModerationCursor returns the id to continue before, 0 for the first page.
+func decodeModerationCursor(kind, cursorStr string) (int64, error) {
+	if cursorStr == "" {
+		return 0, nil
+	}
+	v, err := cursor.Decode(cursorSecret(), kind, cursorStr, 1)
+	if err != nil {
+		return 0, err
+	}
+	return v[0], nil
+}
+
+// buildModerationActionPage cuts the extra row fetched to detect HasMore.
+func buildModerationActionPage(kind string, actions []domain.ModerationAction, limit int) *ModerationActionPage {
+	page := &ModerationActionPage{Actions: actions}
+	if len(actions) > limit {
+		page.Actions = actions[:limit]
+		page.HasMore = true
+		page.NextCursor = cursor.Encode(cursorSecret(), kind, page.Actions[limit-1].ID)
+	}
+	return page
+}
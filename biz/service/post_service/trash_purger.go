@@ -0,0 +1,87 @@
+package post_service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"zetian-personal-website-hertz/biz/config"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+)
+
+/*
+Trash purger
+------------
+DeletePost only moves a post into the trash. The purger runs in the background and
+calls PurgePost (likes / favorites / comments / tags / revisions / S3 media / row)
+for every post that has been in the trash longer than post_trash_retention_days.
+*/
+
+const (
+	defaultTrashRetentionDays = 30
+	trashPurgeInterval        = time.Hour
+	trashPurgeBatchSize       = 100
+)
+
+var purgerStartOnce sync.Once
+
+// trashRetention is how long a post stays restorable in the trash.
+func trashRetention() time.Duration {
+	days := config.GetSpecificConfig().PostTrashRetentionDays
+	if days <= 0 {
+		days = defaultTrashRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// PurgeExpiredTrash purges every post trashed before now - retention.
+// Returns how many posts were purged.
+func PurgeExpiredTrash(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-trashRetention())
+	purged := 0
+	for {
+		ids, err := post_base_repo.ListPostIDsTrashedBefore(ctx, cutoff, trashPurgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+		if len(ids) == 0 {
+			return purged, nil
+		}
+
+		failed := 0
+		for _, id := range ids {
+			if err := PurgePost(ctx, id); err != nil {
+				log.Printf("trash purger: purge post %d failed: %v", id, err)
+				failed++
+				continue
+			}
+			purged++
+		}
+		// 整批都失败就先停下，等下一轮再试，避免死循环
+		if failed == len(ids) {
+			return purged, nil
+		}
+	}
+}
+
+// StartTrashPurger starts the background goroutine that purges expired trash.
+// Calling it more than once has no effect.
+func StartTrashPurger() {
+	purgerStartOnce.Do(func() {
+		go func() {
+			ctx := context.Background()
+			ticker := time.NewTicker(trashPurgeInterval)
+			defer ticker.Stop()
+
+			for {
+				if n, err := PurgeExpiredTrash(ctx); err != nil {
+					log.Printf("trash purger: %v", err)
+				} else if n > 0 {
+					log.Printf("trash purger: %d posts purged", n)
+				}
+				<-ticker.C
+			}
+		}()
+	})
+}
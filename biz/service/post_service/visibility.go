@@ -0,0 +1,41 @@
+package post_service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/service/visibility_service"
+)
+
+// Post visibility rules live in visibility_service (shared with comment_service);
+// the helpers below are the short names used across post_service.
+
+var ErrInvalidVisibility = errors.New("visibility must be one of public / followers / school")
+
+// normalizeVisibility validates the visibility of a new post, "" means public.
+func normalizeVisibility(visibility string, schoolID int64) (string, error) {
+	if visibility == "" {
+		return domain.PostVisibilityPublic, nil
+	}
+	if !domain.IsValidPostVisibility(visibility) {
+		return "", ErrInvalidVisibility
+	}
+	if visibility == domain.PostVisibilitySchool && schoolID == 0 {
+		return "", fmt.Errorf("%w: school-only post needs a school_id", ErrInvalidVisibility)
+	}
+	return visibility, nil
+}
+
+func loadPostViewer(ctx context.Context, viewerID int64) domain.PostViewer {
+	return visibility_service.LoadPostViewer(ctx, viewerID)
+}
+
+func filterVisiblePosts(ctx context.Context, bases []domain.PostBase, viewerID int64) ([]domain.PostBase, error) {
+	return visibility_service.FilterVisiblePosts(ctx, bases, viewerID)
+}
+
+func canViewPost(ctx context.Context, base *domain.PostBase, viewerID int64) (bool, error) {
+	return visibility_service.CanViewPost(ctx, base, viewerID)
+}
@@ -0,0 +1,26 @@
+package post_service
+
+import (
+	"errors"
+	"testing"
+
+	"zetian-personal-website-hertz/biz/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeVisibility(t *testing.T) {
+	v, err := normalizeVisibility("", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PostVisibilityPublic, v)
+
+	v, err = normalizeVisibility(domain.PostVisibilityFollowers, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.PostVisibilityFollowers, v)
+
+	_, err = normalizeVisibility("friends", 1)
+	assert.True(t, errors.Is(err, ErrInvalidVisibility))
+
+	_, err = normalizeVisibility(domain.PostVisibilitySchool, 0)
+	assert.True(t, errors.Is(err, ErrInvalidVisibility))
+}
@@ -0,0 +1,37 @@
+// recompute like / fav / follow counters from the relation tables
+// run from the project root (config is loaded from ./biz/config):
+//
+//	ENV=dev go run ./biz/service/reconcile_service/main            # fix
+//	ENV=dev go run ./biz/service/reconcile_service/main -dry-run   # only report
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"zetian-personal-website-hertz/biz/config"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/service/reconcile_service"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "report drifted counters without fixing them")
+	flag.Parse()
+
+	config.InitConfig()
+	DB.InitPostgres()
+
+	report, err := reconcile_service.Run(context.Background(), *dryRun)
+	for _, d := range report.Drifts {
+		log.Print(d)
+	}
+	if report.DriftCount > len(report.Drifts) {
+		log.Printf("... and %d more", report.DriftCount-len(report.Drifts))
+	}
+	if err != nil {
+		log.Fatalf("reconcile failed: %v", err)
+	}
+	log.Printf("reconcile done (dry-run=%v): %d posts, %d users checked, %d drifted counters, %d rows fixed",
+		report.DryRun, report.PostsChecked, report.UsersChecked, report.DriftCount, report.RowsFixed)
+}
@@ -0,0 +1,210 @@
+package reconcile_service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_fav_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_like_repo"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_stats_repo"
+	"zetian-personal-website-hertz/biz/repository/user_follow_repo"
+	"zetian-personal-website-hertz/biz/repository/user_stats_repo"
+)
+
+/*
+Counter reconciliation
+----------------------
+Counters live next to the relation rows they describe and are normally changed in the
+same transaction, but they can still drift (rows written before that, manual fixes in
+the DB, a purge that deletes likes ...).
+The reconciler recomputes them from the source of truth:
+
+  post_stats.like_count / fav_count   <- post_likes / post_favorites
+  user_stats.followers_count          <- user_follow_records (followee_id)
+  user_stats.following_count          <- user_follow_records (follower_id)
+  user_stats.post_like_received_count <- post_likes on the user's posts (trashed posts included)
+
+Both tables are walked in batches. Only rows that differ are written, and the fix
+recounts inside the UPDATE, so a like / follow happening meanwhile is not overwritten.
+In dry-run mode differences are only reported.
+
+Runs every reconcileInterval in the server (StartReconciler) and on demand:
+
+	ENV=dev go run ./biz/service/reconcile_service/main -dry-run
+*/
+
+const (
+	reconcileBatchSize = 500
+	reconcileInterval  = 24 * time.Hour
+
+	maxReportedDrifts = 1000 // Report.Drifts keeps at most this many, DriftCount counts all
+)
+
+// Drift is one counter whose stored value differs from the recomputed one.
+type Drift struct {
+	Table  string // "post_stats" / "user_stats"
+	ID     int64  // post_id / user_id
+	Column string
+	Stored int64
+	Actual int64
+}
+
+func (d Drift) String() string {
+	return fmt.Sprintf("%s %d %s: stored %d, actual %d", d.Table, d.ID, d.Column, d.Stored, d.Actual)
+}
+
+// Report is the result of one reconciliation run.
+type Report struct {
+	DryRun       bool
+	PostsChecked int
+	UsersChecked int
+	DriftCount   int
+	Drifts       []Drift
+	RowsFixed    int // rows rewritten, always 0 in dry-run mode
+}
+
+func (r *Report) addDrift(d Drift) {
+	r.DriftCount++
+	if len(r.Drifts) < maxReportedDrifts {
+		r.Drifts = append(r.Drifts, d)
+	}
+}
+
+// Run reconciles post and user counters. dryRun = true only reports the differences.
+func Run(ctx context.Context, dryRun bool) (*Report, error) {
+	report := &Report{DryRun: dryRun}
+	if err := reconcilePostStats(ctx, report); err != nil {
+		return report, fmt.Errorf("reconcile post stats: %w", err)
+	}
+	if err := reconcileUserStats(ctx, report); err != nil {
+		return report, fmt.Errorf("reconcile user stats: %w", err)
+	}
+	return report, nil
+}
+
+func reconcilePostStats(ctx context.Context, report *Report) error {
+	var afterID int64
+	for {
+		list, err := post_stats_repo.ListStatsAfterPostID(ctx, afterID, reconcileBatchSize)
+		if err != nil {
+			return fmt.Errorf("list post stats after %d: %w", afterID, err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(list))
+		for _, s := range list {
+			ids = append(ids, s.PostID)
+		}
+		likes, err := post_like_repo.CountLikesBatch(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("count likes: %w", err)
+		}
+		favs, err := post_fav_repo.CountFavoritesBatch(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("count favorites: %w", err)
+		}
+
+		for _, s := range list {
+			report.PostsChecked++
+			drifted := false
+			if int64(s.LikeCount) != int64(likes[s.PostID]) {
+				report.addDrift(Drift{"post_stats", s.PostID, "like_count", int64(s.LikeCount), int64(likes[s.PostID])})
+				drifted = true
+			}
+			if int64(s.FavCount) != int64(favs[s.PostID]) {
+				report.addDrift(Drift{"post_stats", s.PostID, "fav_count", int64(s.FavCount), int64(favs[s.PostID])})
+				drifted = true
+			}
+			if !drifted || report.DryRun {
+				continue
+			}
+			if err := post_stats_repo.RecountLikesAndFavs(ctx, s.PostID); err != nil {
+				return fmt.Errorf("fix post %d: %w", s.PostID, err)
+			}
+			report.RowsFixed++
+		}
+		afterID = list[len(list)-1].PostID
+	}
+}
+
+func reconcileUserStats(ctx context.Context, report *Report) error {
+	var afterID int64
+	for {
+		list, err := user_stats_repo.ListStatsAfterUserID(ctx, afterID, reconcileBatchSize)
+		if err != nil {
+			return fmt.Errorf("list user stats after %d: %w", afterID, err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, 0, len(list))
+		for _, s := range list {
+			ids = append(ids, s.UserID)
+		}
+		followers, err := user_follow_repo.CountFollowersBatch(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("count followers: %w", err)
+		}
+		following, err := user_follow_repo.CountFollowingBatch(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("count following: %w", err)
+		}
+		likesReceived, err := post_like_repo.CountLikesReceivedBatch(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("count likes received: %w", err)
+		}
+
+		for _, s := range list {
+			report.UsersChecked++
+			drifted := false
+			check := func(column string, stored int64, actual int) {
+				if stored != int64(actual) {
+					report.addDrift(Drift{"user_stats", s.UserID, column, stored, int64(actual)})
+					drifted = true
+				}
+			}
+			check("followers_count", s.FollowersCount, followers[s.UserID])
+			check("following_count", s.FollowingCount, following[s.UserID])
+			check("post_like_received_count", s.PostLikeReceivedCount, likesReceived[s.UserID])
+
+			if !drifted || report.DryRun {
+				continue
+			}
+			if err := user_stats_repo.RecountStats(ctx, s.UserID); err != nil {
+				return fmt.Errorf("fix user %d: %w", s.UserID, err)
+			}
+			report.RowsFixed++
+		}
+		afterID = list[len(list)-1].UserID
+	}
+}
+
+var startOnce sync.Once
+
+// StartReconciler starts the background goroutine that fixes drifted counters every reconcileInterval.
+// Calling it more than once has no effect.
+func StartReconciler() {
+	startOnce.Do(func() {
+		go func() {
+			ctx := context.Background()
+			ticker := time.NewTicker(reconcileInterval)
+			defer ticker.Stop()
+
+			for range ticker.C {
+				report, err := Run(ctx, false)
+				if err != nil {
+					log.Printf("reconciler: %v", err)
+				}
+				if report.DriftCount > 0 {
+					log.Printf("reconciler: %d drifted counters, %d rows fixed", report.DriftCount, report.RowsFixed)
+				}
+			}
+		}()
+	})
+}
@@ -0,0 +1,25 @@
+// backfill post_tags from the JSON tags stored in post_bases.tags
+// run from the project root (config is loaded from ./biz/config):
+//
+//	ENV=dev go run ./biz/service/tag_service/main
+package main
+
+import (
+	"context"
+	"log"
+
+	"zetian-personal-website-hertz/biz/config"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/service/tag_service"
+)
+
+func main() {
+	config.InitConfig()
+	DB.InitPostgres()
+
+	n, err := tag_service.BackfillPostTags(context.Background())
+	if err != nil {
+		log.Fatalf("tag backfill failed after %d posts: %v", n, err)
+	}
+	log.Printf("tag backfill done, %d posts synced", n)
+}
@@ -0,0 +1,211 @@
+package tag_service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"zetian-personal-website-hertz/biz/domain"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_base_repo"
+	"zetian-personal-website-hertz/biz/repository/tag_repo"
+
+	"gorm.io/gorm"
+)
+
+const (
+	MaxTagLength = 64
+
+	DefaultTrendingWindow = 24 * time.Hour
+	MaxTrendingWindow     = 7 * 24 * time.Hour
+
+	backfillBatchSize = 500
+)
+
+// NormalizeTag turns user input into the stored form:
+// trim spaces, strip leading '#', lower case.
+// Returns "" if nothing is left or the tag is too long.
+func NormalizeTag(name string) string {
+	name = strings.TrimSpace(name)
+	name = strings.TrimLeft(name, "#＃")
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || utf8.RuneCountInString(name) > MaxTagLength {
+		return ""
+	}
+	return name
+}
+
+// NormalizeTags normalizes and de-duplicates tags, keeping the first-seen order.
+func NormalizeTags(names []string) []string {
+	res := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, n := range names {
+		n = NormalizeTag(n)
+		if n == "" {
+			continue
+		}
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		seen[n] = struct{}{}
+		res = append(res, n)
+	}
+	return res
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// post_tags maintenance
+///////////////////////////////////////////////////////////////////////////////
+
+// SyncPostTags sets post_tags of a post to exactly the given (raw) tags.
+// Called by EditPost / drafts / moderation, and by the backfill.
+func SyncPostTags(ctx context.Context, postID, schoolID int64, createdAt time.Time, tags []string) error {
+	return SyncPostTagsTx(DB.DB.WithContext(ctx), postID, schoolID, createdAt, tags)
+}
+
+// SyncPostTagsTx is SyncPostTags inside tx, so CreatePost writes the post and its tags together.
+func SyncPostTagsTx(tx *gorm.DB, postID, schoolID int64, createdAt time.Time, tags []string) error {
+	names := NormalizeTags(tags)
+
+	tagMap, err := tag_repo.GetOrCreateTagsTx(tx, names)
+	if err != nil {
+		return fmt.Errorf("get or create tags: %w", err)
+	}
+
+	tagIDs := make([]int64, 0, len(names))
+	for _, n := range names {
+		if t, ok := tagMap[n]; ok {
+			tagIDs = append(tagIDs, t.ID)
+		}
+	}
+
+	if err := tag_repo.ReplacePostTagsTx(tx, postID, schoolID, createdAt, tagIDs); err != nil {
+		return fmt.Errorf("replace post tags: %w", err)
+	}
+	return nil
+}
+
+// SyncPostTagsFromBase is SyncPostTags for a PostBase, whose Tags is a JSON string.
+func SyncPostTagsFromBase(ctx context.Context, base domain.PostBase) error {
+	var tags []string
+	if base.Tags != "" {
+		if err := json.Unmarshal([]byte(base.Tags), &tags); err != nil {
+			return fmt.Errorf("unmarshal tags of post %d: %w", base.ID, err)
+		}
+	}
+	return SyncPostTags(ctx, base.ID, base.SchoolID, base.CreatedAt, tags)
+}
+
+// DeletePostTags removes all tag relations of a post (tags themselves are kept).
+func DeletePostTags(ctx context.Context, postID int64) error {
+	return tag_repo.DeletePostTagsByPostID(ctx, postID)
+}
+
+// BackfillPostTags walks every post and rebuilds post_tags from post_bases.tags.
+// Safe to run more than once.
+func BackfillPostTags(ctx context.Context) (int, error) {
+	var (
+		afterID int64
+		total   int
+	)
+	for {
+		bases, err := post_base_repo.ListPostBasesAfterID(ctx, afterID, backfillBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("list posts after %d: %w", afterID, err)
+		}
+		if len(bases) == 0 {
+			return total, nil
+		}
+
+		for _, b := range bases {
+			if err := SyncPostTagsFromBase(ctx, b); err != nil {
+				// 个别脏数据跳过，不影响整体
+				log.Printf("tag backfill: post %d skipped: %v", b.ID, err)
+				continue
+			}
+			total++
+		}
+		afterID = bases[len(bases)-1].ID
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// Trending
+///////////////////////////////////////////////////////////////////////////////
+
+// GetTrendingTags returns the fastest-growing tags:
+// posts in [now-window, now) compared with [now-2*window, now-window).
+//   - schoolID <= 0 means all schools.
+//   - Ordered by growth DESC, then current count DESC, then name.
+//   - Tags not used in the current window are not returned.
+func GetTrendingTags(ctx context.Context, schoolID int64, window time.Duration, limit int) ([]domain.TrendingTag, error) {
+	if window <= 0 {
+		window = DefaultTrendingWindow
+	}
+	if window > MaxTrendingWindow {
+		window = MaxTrendingWindow
+	}
+
+	now := time.Now()
+	curStart := now.Add(-window)
+	prevStart := curStart.Add(-window)
+
+	cur, err := tag_repo.CountTagUsage(ctx, schoolID, curStart, now)
+	if err != nil {
+		return nil, fmt.Errorf("count current window: %w", err)
+	}
+	if len(cur) == 0 {
+		return []domain.TrendingTag{}, nil
+	}
+	prev, err := tag_repo.CountTagUsage(ctx, schoolID, prevStart, curStart)
+	if err != nil {
+		return nil, fmt.Errorf("count previous window: %w", err)
+	}
+
+	tagIDs := make([]int64, 0, len(cur))
+	for id := range cur {
+		tagIDs = append(tagIDs, id)
+	}
+	tagMap, err := tag_repo.GetTagsByIDs(ctx, tagIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get tags: %w", err)
+	}
+
+	res := make([]domain.TrendingTag, 0, len(cur))
+	for id, cnt := range cur {
+		t, ok := tagMap[id]
+		if !ok {
+			continue
+		}
+		res = append(res, domain.TrendingTag{
+			TagID:     id,
+			Name:      t.Name,
+			PostCount: cnt,
+			PrevCount: prev[id],
+		})
+	}
+
+	sortTrendingTags(res)
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
+func sortTrendingTags(tags []domain.TrendingTag) {
+	sort.Slice(tags, func(i, j int) bool {
+		gi, gj := tags[i].Growth(), tags[j].Growth()
+		if gi != gj {
+			return gi > gj
+		}
+		if tags[i].PostCount != tags[j].PostCount {
+			return tags[i].PostCount > tags[j].PostCount
+		}
+		return tags[i].Name < tags[j].Name
+	})
+}
@@ -0,0 +1,30 @@
+package tag_service
+
+import (
+	"strings"
+	"testing"
+	"zetian-personal-website-hertz/biz/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	got := NormalizeTags([]string{" #Exam ", "exam", "##Final", "", "  ", "#", strings.Repeat("a", MaxTagLength+1), "考试"})
+	assert.Equal(t, []string{"exam", "final", "考试"}, got)
+}
+
+func TestSortTrendingTags(t *testing.T) {
+	tags := []domain.TrendingTag{
+		{Name: "stable", PostCount: 50, PrevCount: 48},
+		{Name: "rising", PostCount: 12, PrevCount: 1},
+		{Name: "new-b", PostCount: 3},
+		{Name: "new-a", PostCount: 3},
+	}
+	sortTrendingTags(tags)
+
+	names := make([]string, 0, len(tags))
+	for _, tg := range tags {
+		names = append(names, tg.Name)
+	}
+	assert.Equal(t, []string{"rising", "new-a", "new-b", "stable"}, names)
+}
@@ -7,6 +7,8 @@ import (
 	"log"
 	"zetian-personal-website-hertz/biz/domain"
 	"zetian-personal-website-hertz/biz/pkg/crypto"
+	DB "zetian-personal-website-hertz/biz/repository"
+	"zetian-personal-website-hertz/biz/repository/school_repo"
 	"zetian-personal-website-hertz/biz/repository/user_follow_repo"
 	"zetian-personal-website-hertz/biz/repository/user_repo"
 	"zetian-personal-website-hertz/biz/repository/user_stats_repo"
@@ -218,8 +220,42 @@ func UpdateAvatarURL(ctx context.Context, userID int64, avatarURL string) error
 }
 
 
+var ErrSchoolNotVerified = errors.New("your email does not belong to this school")
+
+// UpdateSchool sets the user's own school (school-only posts of that school become visible).
+// schoolID == 0 clears it.
+// The school must accept the user's email (verified at sign up, see School.AcceptsMember),
+// otherwise ErrSchoolNotVerified: anybody could join any school and read its school-only posts.
+func UpdateSchool(ctx context.Context, userID, schoolID int64) error {
+	if schoolID < 0 {
+		return fmt.Errorf("invalid school id")
+	}
+	if schoolID > 0 {
+		s, err := school_repo.GetSchoolByIDInCache(schoolID)
+		if err != nil || s == nil {
+			return fmt.Errorf("school %d not found", schoolID)
+		}
+		u, err := user_repo.GetUserByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if !s.AcceptsMember(u.Email) {
+			return ErrSchoolNotVerified
+		}
+	}
+	return user_repo.UpdateUserSchoolID(ctx, userID, schoolID)
+}
+
+
+// UpdatePrivacy sets whether other users can list the posts userID has liked.
+func UpdatePrivacy(ctx context.Context, userID int64, showLikedPosts bool) error {
+	return user_repo.UpdateUserShowLikedPosts(ctx, userID, showLikedPosts)
+}
+
 
 // FollowUser 让 followerID 关注 followeeID
+// 关注关系和双方的 followers_count / following_count 在同一个事务里写，要么都成功要么都失败；
+// 已经关注过（包括并发的重复请求）时插入不会生效，计数也不会动
 func FollowUser(ctx context.Context, followerID, followeeID int64) error {
 	if followerID <= 0 || followeeID <= 0 {
 		return fmt.Errorf("invalid user id")
@@ -228,31 +264,31 @@ func FollowUser(ctx context.Context, followerID, followeeID int64) error {
 		return fmt.Errorf("cannot follow yourself")
 	}
 
-	// 1) 先判断是否已经关注（幂等）
-	isFollowing, err := user_follow_repo.IsFollowing(ctx, followerID, followeeID)
-	if err != nil {
-		return fmt.Errorf("check follow state failed: %w", err)
-	}
-	if isFollowing {
-		// 已经关注了，直接返回成功
-		return nil
-	}
-
-	// 2) 插入关注关系
-	if err := user_follow_repo.Follow(ctx, followerID, followeeID); err != nil {
-		return fmt.Errorf("create follow record failed: %w", err)
-	}
-
-	// 3) 更新统计：被关注者 followers_count +1，关注者 following_count +1
-	// 这里都当作 best-effort，失败不影响主流程
-	_ = user_stats_repo.IncrementFollowers(ctx, followeeID, 1)
-	_ = user_stats_repo.IncrementFollowing(ctx, followerID, 1)
+	err := DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1) 插入关注关系（幂等）
+		inserted, err := user_follow_repo.FollowTx(tx, followerID, followeeID)
+		if err != nil {
+			return fmt.Errorf("create follow record failed: %w", err)
+		}
+		if !inserted {
+			// 已经关注了，直接返回成功
+			return nil
+		}
 
-	return nil
+		// 2) 更新统计：被关注者 followers_count +1，关注者 following_count +1
+		if err := user_stats_repo.IncrementFollowersTx(tx, followeeID, 1); err != nil {
+			return fmt.Errorf("update followers count failed: %w", err)
+		}
+		if err := user_stats_repo.IncrementFollowingTx(tx, followerID, 1); err != nil {
+			return fmt.Errorf("update following count failed: %w", err)
+		}
+		return nil
+	})
+	return err
 }
 
 
-// UnfollowUser 让 followerID 取消关注 followeeID
+// UnfollowUser 让 followerID 取消关注 followeeID（事务规则同 FollowUser）
 func UnfollowUser(ctx context.Context, followerID, followeeID int64) error {
 	if followerID <= 0 || followeeID <= 0 {
 		return fmt.Errorf("invalid user id")
@@ -261,26 +297,26 @@ func UnfollowUser(ctx context.Context, followerID, followeeID int64) error {
 		return fmt.Errorf("cannot unfollow yourself")
 	}
 
-	// 1) 先判断是否当前有关注关系（避免计数乱减）
-	isFollowing, err := user_follow_repo.IsFollowing(ctx, followerID, followeeID)
-	if err != nil {
-		return fmt.Errorf("check follow state failed: %w", err)
-	}
-	if !isFollowing {
-		// 本来就没关注，当作成功
-		return nil
-	}
-
-	// 2) 删除关注关系
-	if err := user_follow_repo.Unfollow(ctx, followerID, followeeID); err != nil {
-		return fmt.Errorf("delete follow record failed: %w", err)
-	}
-
-	// 3) 更新统计：被关注者 followers_count -1，关注者 following_count -1
-	_ = user_stats_repo.IncrementFollowers(ctx, followeeID, -1)
-	_ = user_stats_repo.IncrementFollowing(ctx, followerID, -1)
+	err := DB.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1) 删除关注关系，本来就没关注当作成功（避免计数乱减）
+		removed, err := user_follow_repo.UnfollowTx(tx, followerID, followeeID)
+		if err != nil {
+			return fmt.Errorf("delete follow record failed: %w", err)
+		}
+		if !removed {
+			return nil
+		}
 
-	return nil
+		// 2) 更新统计：被关注者 followers_count -1，关注者 following_count -1
+		if err := user_stats_repo.IncrementFollowersTx(tx, followeeID, -1); err != nil {
+			return fmt.Errorf("update followers count failed: %w", err)
+		}
+		if err := user_stats_repo.IncrementFollowingTx(tx, followerID, -1); err != nil {
+			return fmt.Errorf("update following count failed: %w", err)
+		}
+		return nil
+	})
+	return err
 }
 
 const (
@@ -294,6 +330,50 @@ type FollowListResult struct {
 	HasMore    bool
 }
 
+// GetSimpleUserProfiles 批量组装 ids 对应的 SimpleUserProfile（顺序和 ids 一致，不存在的用户跳过），
+// 带上 viewer 相关的 IsFollowing / FollowedYou / IsMe；关系查询失败只打日志。
+func GetSimpleUserProfiles(ctx context.Context, viewerID int64, ids []int64) ([]domain.SimpleUserProfile, error) {
+	if len(ids) == 0 {
+		return []domain.SimpleUserProfile{}, nil
+	}
+
+	// 1. 批量拉用户基本信息
+	userMap, err := user_repo.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 批量判断关系：viewer -> others / others -> viewer
+	followingMap, err := user_follow_repo.BatchIsFollowing(ctx, viewerID, ids)
+	if err != nil {
+		log.Printf("BatchIsFollowing error: %v", err)
+	}
+	followedByMap, err := user_follow_repo.BatchIsFollowedBy(ctx, viewerID, ids)
+	if err != nil {
+		log.Printf("BatchIsFollowedBy error: %v", err)
+	}
+
+	// 3. 组装 SimpleUserProfile
+	result := make([]domain.SimpleUserProfile, 0, len(ids))
+	for _, uid := range ids {
+		u, ok := userMap[uid]
+		if !ok || u == nil {
+			continue
+		}
+
+		sp := domain.SimpleUserProfile{
+			Id:          uid,
+			UserName:    u.Username,
+			AvatarUrl:   u.AvatarUrl,
+			IsFollowing: followingMap[uid],  // viewer -> uid
+			FollowedYou: followedByMap[uid], // uid -> viewer
+			IsMe:        viewerID != 0 && viewerID == uid,
+		}
+		result = append(result, sp)
+	}
+	return result, nil
+}
+
 // GetFollowers 返回「targetUserID 的粉丝列表」（谁 follow 了 ta）
 func GetFollowers(
 	ctx context.Context,
@@ -333,42 +413,12 @@ func GetFollowers(
 		ids = append(ids, r.FollowerID)
 	}
 
-	// 3. 批量拉用户基本信息
-	userMap, err := user_repo.GetUsersByIDs(ctx, ids)
+	// 3. 批量拉用户信息 + 关系（保持和 records 顺序一致）
+	result, err := GetSimpleUserProfiles(ctx, viewerID, ids)
 	if err != nil {
 		return nil, err
 	}
 
-	// 4. 批量判断关系：viewer -> others / others -> viewer
-	followingMap, err := user_follow_repo.BatchIsFollowing(ctx, viewerID, ids)
-	if err != nil {
-		log.Printf("BatchIsFollowing error: %v", err)
-	}
-	followedByMap, err := user_follow_repo.BatchIsFollowedBy(ctx, viewerID, ids)
-	if err != nil {
-		log.Printf("BatchIsFollowedBy error: %v", err)
-	}
-
-	// 5. 组装 SimpleUserProfile（保持和 records 顺序一致）
-	result := make([]domain.SimpleUserProfile, 0, len(records))
-	for _, r := range records {
-		uid := r.FollowerID
-		u, ok := userMap[uid]
-		if !ok || u == nil {
-			continue
-		}
-
-		sp := domain.SimpleUserProfile{
-			Id:         uid,
-			UserName:   u.Username,
-			AvatarUrl:  u.AvatarUrl,
-			IsFollowing: followingMap[uid],  // viewer -> uid
-			FollowedYou: followedByMap[uid], // uid -> viewer
-			IsMe:        viewerID != 0 && viewerID == uid,
-		}
-		result = append(result, sp)
-	}
-
 	return &FollowListResult{
 		Users:      result,
 		NextCursor: nextCursor,
@@ -415,42 +465,12 @@ func GetFollowees(
 		ids = append(ids, r.FolloweeID)
 	}
 
-	// 3. 批量拉用户基本信息
-	userMap, err := user_repo.GetUsersByIDs(ctx, ids)
+	// 3. 批量拉用户信息 + 关系，保证顺序
+	result, err := GetSimpleUserProfiles(ctx, viewerID, ids)
 	if err != nil {
 		return nil, err
 	}
 
-	// 4. 批量判断关系
-	followingMap, err := user_follow_repo.BatchIsFollowing(ctx, viewerID, ids)
-	if err != nil {
-		log.Printf("BatchIsFollowing error: %v", err)
-	}
-	followedByMap, err := user_follow_repo.BatchIsFollowedBy(ctx, viewerID, ids)
-	if err != nil {
-		log.Printf("BatchIsFollowedBy error: %v", err)
-	}
-
-	// 5. 组装 SimpleUserProfile，保证顺序
-	result := make([]domain.SimpleUserProfile, 0, len(records))
-	for _, r := range records {
-		uid := r.FolloweeID
-		u, ok := userMap[uid]
-		if !ok || u == nil {
-			continue
-		}
-
-		sp := domain.SimpleUserProfile{
-			Id:         uid,
-			UserName:   u.Username,
-			AvatarUrl:  u.AvatarUrl,
-			IsFollowing: followingMap[uid],
-			FollowedYou: followedByMap[uid],
-			IsMe:        viewerID != 0 && viewerID == uid,
-		}
-		result = append(result, sp)
-	}
-
 	return &FollowListResult{
 		Users:      result,
 		NextCursor: nextCursor,
@@ -0,0 +1,178 @@
+package view_service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"zetian-personal-website-hertz/biz/repository/post_repo/post_stats_repo"
+	"zetian-personal-website-hertz/biz/service/hot_score_service"
+)
+
+/*
+View counting
+-------------
+GetPost used to run one UPDATE per read and every refresh counted again. Now:
+  - a view of a post by the same viewer is counted at most once per dedupWindow;
+    logged-in viewers are told apart by user id, anonymous ones by client IP
+    (so everyone behind one NAT counts once per window, good enough here);
+  - counted views are buffered in memory (post_id -> delta) and written to
+    post_stats.view_count every flushInterval, in batches of flushBatchSize posts
+    per UPDATE, so a hot post costs one UPDATE per interval instead of one per read;
+  - main registers Flush as a shutdown hook, views buffered since the last tick are
+    not lost on a normal restart (a crash loses at most flushInterval of views).
+
+Everything is per process: with several instances a viewer can be counted once per
+instance within the window, which is fine for a view counter.
+*/
+
+const (
+	dedupWindow    = 30 * time.Minute
+	flushInterval  = 10 * time.Second
+	flushBatchSize = 500
+)
+
+// viewKey identifies a viewer of a post: viewerID > 0 for a logged-in user,
+// otherwise viewerID is 0 and clientIP tells anonymous viewers apart.
+type viewKey struct {
+	postID   int64
+	viewerID int64
+	clientIP string
+}
+
+func newViewKey(postID, viewerID int64, clientIP string) viewKey {
+	if viewerID > 0 {
+		return viewKey{postID: postID, viewerID: viewerID}
+	}
+	return viewKey{postID: postID, clientIP: clientIP}
+}
+
+// aggregator dedups views and buffers the increments until they are flushed.
+type aggregator struct {
+	mu      sync.Mutex
+	window  time.Duration
+	seen    map[viewKey]time.Time // when the view was last counted
+	pending map[int64]int32       // post_id -> views not yet written
+}
+
+func newAggregator(window time.Duration) *aggregator {
+	return &aggregator{
+		window:  window,
+		seen:    make(map[viewKey]time.Time),
+		pending: make(map[int64]int32),
+	}
+}
+
+// record counts a view at now, unless the same viewer of the post was counted within the window.
+func (a *aggregator) record(key viewKey, now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if last, ok := a.seen[key]; ok && now.Sub(last) < a.window {
+		return false
+	}
+	a.seen[key] = now
+	a.pending[key.postID]++
+	return true
+}
+
+// take swaps out the pending increments and forgets views that left the window.
+func (a *aggregator) take(now time.Time) map[int64]int32 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for key, last := range a.seen {
+		if now.Sub(last) >= a.window {
+			delete(a.seen, key)
+		}
+	}
+
+	if len(a.pending) == 0 {
+		return nil
+	}
+	deltas := a.pending
+	a.pending = make(map[int64]int32)
+	return deltas
+}
+
+// putBack re-adds increments whose flush failed, they are retried on the next flush.
+func (a *aggregator) putBack(deltas map[int64]int32) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for postID, n := range deltas {
+		a.pending[postID] += n
+	}
+}
+
+var (
+	views = newAggregator(dedupWindow)
+
+	startOnce sync.Once
+)
+
+// RecordView counts a view of postID (deduplicated, buffered). viewerID <= 0 means
+// an anonymous viewer, deduplicated by clientIP instead.
+// Returns whether the view was counted. Never blocks on DB.
+func RecordView(postID, viewerID int64, clientIP string) bool {
+	if postID <= 0 {
+		return false
+	}
+	return views.record(newViewKey(postID, viewerID, clientIP), time.Now())
+}
+
+// Flush writes buffered views to post_stats and marks the posts for hot score recompute.
+// Batches that fail are kept in memory for the next flush.
+func Flush(ctx context.Context) error {
+	deltas := views.take(time.Now())
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	var firstErr error
+	batch := make(map[int64]int32, flushBatchSize)
+	flushBatch := func() {
+		if err := post_stats_repo.IncrementViewsBatch(ctx, batch); err != nil {
+			views.putBack(batch)
+			if firstErr == nil {
+				firstErr = fmt.Errorf("flush %d post views: %w", len(batch), err)
+			}
+		} else {
+			for postID := range batch {
+				hot_score_service.Touch(postID)
+			}
+		}
+		batch = make(map[int64]int32, flushBatchSize)
+	}
+
+	for postID, n := range deltas {
+		batch[postID] = n
+		if len(batch) >= flushBatchSize {
+			flushBatch()
+		}
+	}
+	if len(batch) > 0 {
+		flushBatch()
+	}
+	return firstErr
+}
+
+// StartViewFlusher starts the background goroutine that flushes buffered views every flushInterval.
+// Calling it more than once has no effect.
+func StartViewFlusher() {
+	startOnce.Do(func() {
+		go func() {
+			ctx := context.Background()
+			ticker := time.NewTicker(flushInterval)
+			defer ticker.Stop()
+
+			for range ticker.C {
+				if err := Flush(ctx); err != nil {
+					log.Printf("view flusher: %v", err)
+				}
+			}
+		}()
+	})
+}
@@ -0,0 +1,61 @@
+package view_service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregatorDedupAndTake(t *testing.T) {
+	a := newAggregator(30 * time.Minute)
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, a.record(newViewKey(1, 100, ""), now))
+	assert.False(t, a.record(newViewKey(1, 100, ""), now.Add(time.Minute))) // refresh
+	assert.True(t, a.record(newViewKey(1, 101, ""), now))
+	assert.True(t, a.record(newViewKey(2, 100, ""), now))
+
+	assert.Equal(t, map[int64]int32{1: 2, 2: 1}, a.take(now.Add(time.Minute)))
+	assert.Nil(t, a.take(now.Add(time.Minute)))
+
+	// counted again once the window has passed
+	assert.True(t, a.record(newViewKey(1, 100, ""), now.Add(30*time.Minute)))
+	assert.Equal(t, map[int64]int32{1: 1}, a.take(now.Add(30*time.Minute)))
+}
+
+func TestAggregatorAnonymousByIP(t *testing.T) {
+	a := newAggregator(30 * time.Minute)
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, a.record(newViewKey(1, 0, "10.0.0.1"), now))
+	assert.False(t, a.record(newViewKey(1, -1, "10.0.0.1"), now.Add(time.Minute))) // refresh
+	assert.True(t, a.record(newViewKey(1, 0, "10.0.0.2"), now))
+	// a logged-in viewer on the same IP is a different viewer
+	assert.True(t, a.record(newViewKey(1, 100, "10.0.0.1"), now))
+
+	assert.Equal(t, map[int64]int32{1: 3}, a.take(now.Add(time.Minute)))
+}
+
+func TestAggregatorPutBack(t *testing.T) {
+	a := newAggregator(time.Minute)
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	a.record(newViewKey(1, 100, ""), now)
+	failed := a.take(now)
+	a.record(newViewKey(1, 101, ""), now)
+	a.putBack(failed)
+
+	assert.Equal(t, map[int64]int32{1: 2}, a.take(now))
+}
+
+func TestAggregatorForgetsOldViews(t *testing.T) {
+	a := newAggregator(time.Minute)
+	now := time.Date(2025, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	a.record(newViewKey(1, 100, ""), now)
+	a.record(newViewKey(2, 100, ""), now.Add(50*time.Second))
+	a.take(now.Add(time.Minute))
+
+	assert.Len(t, a.seen, 1)
+}
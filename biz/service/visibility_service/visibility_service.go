@@ -0,0 +1,144 @@
+package visibility_service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"zetian-personal-website-hertz/biz/config"
+	"zetian-personal-website-hertz/biz/domain"
+	"zetian-personal-website-hertz/biz/repository/school_repo"
+	"zetian-personal-website-hertz/biz/repository/user_follow_repo"
+	"zetian-personal-website-hertz/biz/repository/user_repo"
+
+	"gorm.io/gorm"
+)
+
+/*
+Post visibility
+---------------
+  - public:    everyone (also not logged in).
+  - followers: the author + users following the author (user_follow_repo).
+  - school:    the author + users whose own school (users.school_id) is the post's school.
+
+Feed queries filter in SQL (post_base_repo.visibleTo), FilterVisiblePosts filters again so
+every path that hydrates posts (GetPost, drafts, quoted posts...) obeys the same rule.
+
+CheckPostReadable is the gate of a single post and everything hanging on it (the post itself,
+its comments, its edit history): drafts / scheduled posts and posts hidden by moderation are
+only readable by the author (hidden ones also by moderators), the rest follow visibility.
+Lives in its own package so comment_service can use it (post_service imports comment_service).
+*/
+
+// IsModerator reports whether userID may handle reports (config moderator_user_ids).
+func IsModerator(userID int64) bool {
+	return userID > 0 && slices.Contains(config.GetSpecificConfig().ModeratorUserIDs, userID)
+}
+
+// LoadPostViewer resolves the viewer's school, viewerID <= 0 is an anonymous viewer.
+// A missing user is treated as a viewer without school, and so is a user whose school does
+// the school does not accept (school set before UpdateSchool checked it, see School.AcceptsMember).
+func LoadPostViewer(ctx context.Context, viewerID int64) domain.PostViewer {
+	viewer := domain.PostViewer{UserID: viewerID}
+	if viewerID <= 0 {
+		return viewer
+	}
+	u, err := user_repo.GetUserByID(ctx, viewerID)
+	if err != nil || u == nil || u.SchoolID == 0 {
+		return viewer
+	}
+	if s, err := school_repo.GetSchoolByIDInCache(u.SchoolID); err == nil && s != nil && s.AcceptsMember(u.Email) {
+		viewer.SchoolID = u.SchoolID
+	}
+	return viewer
+}
+
+// FilterVisiblePosts keeps bases viewerID is allowed to see, order preserved.
+// The viewer / follow lookups are only done when there is a non-public post of someone else.
+func FilterVisiblePosts(ctx context.Context, bases []domain.PostBase, viewerID int64) ([]domain.PostBase, error) {
+	restricted := false
+	for _, b := range bases {
+		if b.Visibility != domain.PostVisibilityPublic && b.UserID != viewerID {
+			restricted = true
+			break
+		}
+	}
+	if !restricted {
+		return bases, nil
+	}
+
+	var viewer domain.PostViewer
+	following := map[int64]bool{}
+	if viewerID > 0 {
+		viewer = LoadPostViewer(ctx, viewerID)
+
+		authorIDs := make([]int64, 0, len(bases))
+		for _, b := range bases {
+			if b.Visibility == domain.PostVisibilityFollowers && b.UserID != viewerID {
+				authorIDs = append(authorIDs, b.UserID)
+			}
+		}
+		m, err := user_follow_repo.BatchIsFollowing(ctx, viewerID, authorIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check following: %w", err)
+		}
+		following = m
+	}
+
+	res := make([]domain.PostBase, 0, len(bases))
+	for _, b := range bases {
+		if canView(b, viewer, following[b.UserID]) {
+			res = append(res, b)
+		}
+	}
+	return res, nil
+}
+
+// CanViewPost reports whether viewerID is allowed to see base (visibility only).
+func CanViewPost(ctx context.Context, base *domain.PostBase, viewerID int64) (bool, error) {
+	visible, err := FilterVisiblePosts(ctx, []domain.PostBase{*base}, viewerID)
+	if err != nil {
+		return false, err
+	}
+	return len(visible) == 1, nil
+}
+
+// CheckPostReadable returns gorm.ErrRecordNotFound (wrapped) if viewerID may not read base,
+// see the package comment. Trashed posts are expected to be filtered out by the caller's query.
+func CheckPostReadable(ctx context.Context, base *domain.PostBase, viewerID int64) error {
+	isAuthor := viewerID > 0 && viewerID == base.UserID
+
+	// drafts / scheduled posts are only visible to the author
+	if base.Status != domain.PostStatusPublished && !isAuthor {
+		return fmt.Errorf("failed to load post: %w", gorm.ErrRecordNotFound)
+	}
+	// posts hidden by moderation are only visible to the author and moderators
+	if base.Hidden && !isAuthor && !IsModerator(viewerID) {
+		return fmt.Errorf("failed to load post: %w", gorm.ErrRecordNotFound)
+	}
+	// followers-only / school-only posts look like missing posts to other viewers
+	visible, err := CanViewPost(ctx, base, viewerID)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return fmt.Errorf("failed to load post: %w", gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// canView is the visibility rule itself; following = viewer follows the author.
+func canView(base domain.PostBase, viewer domain.PostViewer, following bool) bool {
+	if viewer.UserID > 0 && base.UserID == viewer.UserID {
+		return true
+	}
+	switch base.Visibility {
+	case domain.PostVisibilityPublic, "": // "" = row built in memory before the default applied
+		return true
+	case domain.PostVisibilityFollowers:
+		return viewer.UserID > 0 && following
+	case domain.PostVisibilitySchool:
+		return viewer.UserID > 0 && viewer.SchoolID != 0 && base.SchoolID == viewer.SchoolID
+	}
+	return false
+}
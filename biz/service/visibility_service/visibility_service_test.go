@@ -0,0 +1,49 @@
+package visibility_service
+
+import (
+	"testing"
+
+	"zetian-personal-website-hertz/biz/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanView(t *testing.T) {
+	anonymous := domain.PostViewer{UserID: -1}
+	sameSchool := domain.PostViewer{UserID: 2, SchoolID: 7}
+	otherSchool := domain.PostViewer{UserID: 3, SchoolID: 8}
+	author := domain.PostViewer{UserID: 1}
+
+	public := domain.PostBase{UserID: 1, SchoolID: 7, Visibility: domain.PostVisibilityPublic}
+	followers := domain.PostBase{UserID: 1, SchoolID: 7, Visibility: domain.PostVisibilityFollowers}
+	school := domain.PostBase{UserID: 1, SchoolID: 7, Visibility: domain.PostVisibilitySchool}
+
+	assert.True(t, canView(public, anonymous, false))
+
+	assert.False(t, canView(followers, anonymous, false))
+	assert.False(t, canView(followers, sameSchool, false))
+	assert.True(t, canView(followers, sameSchool, true))
+	assert.True(t, canView(followers, author, false))
+
+	assert.False(t, canView(school, anonymous, false))
+	assert.True(t, canView(school, sameSchool, false))
+	assert.False(t, canView(school, otherSchool, true))
+	assert.True(t, canView(school, author, false))
+
+	// viewer without school never matches a school-only post
+	assert.False(t, canView(domain.PostBase{UserID: 1, Visibility: domain.PostVisibilitySchool}, domain.PostViewer{UserID: 4}, false))
+}
+
+func TestSchoolMatchesEmail(t *testing.T) {
+	bu := domain.School{EmailDomains: []string{"bu.edu"}}
+	assert.True(t, bu.MatchesEmail("sky@bu.edu"))
+	assert.True(t, bu.MatchesEmail("sky@cs.BU.edu"))
+	assert.False(t, bu.MatchesEmail("sky@notbu.edu"))
+	assert.False(t, bu.MatchesEmail("sky@gmail.com"))
+	assert.False(t, (&domain.School{}).MatchesEmail("sky@bu.edu"))
+
+	// no domains yet: everybody may join, as before email_domains existed
+	assert.True(t, (&domain.School{}).AcceptsMember("sky@gmail.com"))
+	assert.True(t, bu.AcceptsMember("sky@bu.edu"))
+	assert.False(t, bu.AcceptsMember("sky@gmail.com"))
+}
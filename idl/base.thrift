@@ -6,6 +6,8 @@ include "verification.thrift"
 include "post.thrift"
 include "school.thrift"
 include "category.thrift"
+include "comment.thrift"
+include "tag.thrift"
 
 service UserService {
     user.LoginResp Login(1: user.LoginReq request) (api.post="/login");
@@ -14,6 +16,8 @@ service UserService {
     user.GetUserResp GetUser(1: user.GetUserReq request) (api.get="/user/get");
     user.ResetPasswordResp ResetPassword(1: user.ResetPasswordReq request) (api.post="/user/reset-password");
     user.UpdateAvatarResp UpdateAvatar(1: user.UpdateAvatarReq request) (api.post="/user/update-avatar");
+    user.UpdateSchoolResp UpdateSchool(1: user.UpdateSchoolReq request) (api.post="/user/update-school");
+    user.UpdatePrivacyResp UpdatePrivacy(1: user.UpdatePrivacyReq request) (api.post="/user/update-privacy");
 
     user.FollowUserResp FollowUser(1: user.FollowUserReq request) (api.post="/user/follow");
     user.UnfollowUserResp UnfollowUser(1: user.UnfollowUserReq request) (api.post="/user/unfollow");
@@ -40,17 +44,73 @@ service VerificationService {
 
 service PostService {
     post.GetPostByIDResp GetPostByID(1: post.GetPostByIDReq request) (api.get="/post/get")
+    post.BatchGetPostsResp BatchGetPosts(1: post.BatchGetPostsReq request) (api.post="/post/batch_get")
 
     post.CreatePostResp CreatePost(1: post.CreatePostReq request) (api.post="/post/create")
 
     post.EditPostResp EditPost(1: post.EditPostReq request) (api.post="/post/edit")
 
+    //vote on the poll of a post, user is taken from JWT
+    post.VotePollResp VotePoll(1: post.VotePollReq request) (api.post="/post/poll/vote")
+
+    //drafts / scheduled posts, only the author, user is taken from JWT
+    post.SaveDraftResp SaveDraft(1: post.SaveDraftReq request) (api.post="/post/draft/save")
+    post.UpdateDraftResp UpdateDraft(1: post.UpdateDraftReq request) (api.post="/post/draft/update")
+    post.PublishDraftResp PublishDraft(1: post.PublishDraftReq request) (api.post="/post/draft/publish")
+    post.GetMyDraftsResp GetMyDrafts(1: post.GetMyDraftsReq request) (api.get="/post/drafts")
+
+    //earlier versions of an edited post
+    post.GetPostRevisionsResp GetPostRevisions(1: post.GetPostRevisionsReq request) (api.get="/post/revisions")
+
     post.DeletePostResp DeletePost(1: post.DeletePostReq request) (api.post="/post/delete")
 
+    //trash / restore, only the author, user is taken from JWT
+    post.GetTrashedPostsResp GetTrashedPosts(1: post.GetTrashedPostsReq request) (api.get="/post/trash")
+    post.RestorePostResp RestorePost(1: post.RestorePostReq request) (api.post="/post/restore")
+
     post.GetSchoolRecentPostsResp GetSchoolRecentPosts(1: post.GetSchoolRecentPostsReq request) (api.get="/post/school/recent")
 
+    post.GetCategoryRecentPostsResp GetCategoryRecentPosts(1: post.GetCategoryRecentPostsReq request) (api.get="/post/category/recent")
+
+    post.GetNearbyPostsResp GetNearbyPosts(1: post.GetNearbyPostsReq request) (api.get="/post/nearby")
+
+    //posts the caller favorited, only the owner, user is taken from JWT
+    post.GetFavoritedPostsResp GetFavoritedPosts(1: post.GetFavoritedPostsReq request) (api.get="/post/favorites")
+    //posts a user liked, visible to others only if the user allows it (show_liked_posts)
+    post.GetLikedPostsResp GetLikedPosts(1: post.GetLikedPostsReq request) (api.get="/post/liked")
+
+    //users who liked a post
+    post.GetPostLikersResp GetPostLikers(1: post.GetPostLikersReq request) (api.get="/post/likers")
+
+    //report a post; moderators (config moderator_user_ids) handle the reports
+    post.ReportPostResp ReportPost(1: post.ReportPostReq request) (api.post="/post/report")
+    post.GetPendingReportsResp GetPendingReports(1: post.GetPendingReportsReq request) (api.get="/post/moderation/reports")
+    post.ResolvePostReportsResp ResolvePostReports(1: post.ResolvePostReportsReq request) (api.post="/post/moderation/resolve")
+    post.GetModerationActionsResp GetModerationActions(1: post.GetModerationActionsReq request) (api.get="/post/moderation/actions")
+    //warnings / hides / removals of the caller's own posts
+    post.GetModerationActionsResp GetMyModerationActions(1: post.GetMyModerationActionsReq request) (api.get="/post/moderation/mine")
+
+    //pin / unpin own posts on the profile (max 3)
+    post.PinPostResp PinPost(1: post.PinPostReq request) (api.post="/post/pin")
+    post.UnpinPostResp UnpinPost(1: post.UnpinPostReq request) (api.post="/post/unpin")
+
+    //posts quoting a post
+    post.GetPostQuotesResp GetPostQuotes(1: post.GetPostQuotesReq request) (api.get="/post/quotes")
+
+    post.GetMentionedPostsResp GetMentionedPosts(1: post.GetMentionedPostsReq request) (api.get="/post/mentions")
+
     post.GetPersonalRecentPostsReq GetPersonalRecentPosts(1: post.GetPersonalRecentPostsResp request) (api.get="/post/personal")
 
+    //recent posts of everyone the viewer follows, viewer is taken from JWT
+    post.GetFollowingUsersRecentPostsResp GetFollowingUsersRecentPosts(1: post.GetFollowingUsersRecentPostsReq request) (api.get="/post/following/recent")
+
+    //posts ordered by hot_score, within one school / across all schools
+    post.GetSchoolHotPostsResp GetSchoolHotPosts(1: post.GetSchoolHotPostsReq request) (api.get="/post/school/hot")
+    post.GetHotPostsResp GetHotPosts(1: post.GetHotPostsReq request) (api.get="/post/hot")
+
+    //full-text search with filters, viewer is optional (JWT)
+    post.SearchPostsResp SearchPosts(1: post.SearchPostsReq request) (api.get="/post/search")
+
     //like, unlike, fav, unfav will authorize user based on the JWT
     //user_id likes post_id, but will check whether user_id == JWT
     //looks like LikePostReq and UserFlagPostResq are typo, TOFIX later
@@ -72,6 +132,24 @@ service CategoryService {
 }
 
 
+//comment, like / unlike will authorize user based on the JWT
+service CommentService {
+    comment.CreateCommentResp CreateComment(1: comment.CreateCommentReq request) (api.post="/comment/create");
+    comment.DeleteCommentResp DeleteComment(1: comment.DeleteCommentReq request) (api.post="/comment/delete");
+    comment.GetPostCommentsResp GetPostComments(1: comment.GetPostCommentsReq request) (api.get="/comment/list");
+    comment.GetCommentRepliesResp GetCommentReplies(1: comment.GetCommentRepliesReq request) (api.get="/comment/replies");
+
+    comment.UserFlagCommentResp LikeComment(1: comment.LikeCommentReq request) (api.post="/comment/like");
+    comment.UserFlagCommentResp UnlikeComment(1: comment.UnlikeCommentReq request) (api.post="/comment/unlike");
+}
+
+
+service TagService {
+    tag.GetTagPostsResp GetTagPosts(1: tag.GetTagPostsReq request) (api.get="/tag/posts");
+    tag.GetTrendingTagsResp GetTrendingTags(1: tag.GetTrendingTagsReq request) (api.get="/tag/trending");
+}
+
+
 //Time format:
 //RFC3339
 //2025-11-03T05:59:09.392415Z
\ No newline at end of file
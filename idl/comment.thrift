@@ -0,0 +1,99 @@
+namespace go comment
+
+// RFC3339Nano time strings, like "2025-11-03T00:12:34.123456789Z"
+// Threading:
+//   - top-level comment: root_id = 0, parent_id = 0
+//   - reply: root_id = id of the top-level comment of the thread,
+//            parent_id = id of the comment being replied to (top-level or another reply)
+struct Comment {
+    1: i64 id,
+    2: i64 post_id,
+    3: i64 user_id,
+    4: string user_name,
+    5: string user_avatar_url,
+
+    6: i64 root_id,
+    7: i64 parent_id,
+    8: i64 reply_to_user_id,       // author of parent comment, 0 for top-level
+    9: string reply_to_user_name,
+
+    10: string content,
+
+    11: i32 like_count,
+    12: i32 reply_count,           // only meaningful for top-level comments
+    13: bool is_liked_by_user,
+
+    14: string created_at,
+}
+
+//create------------------------------------------------------------
+// user is taken from JWT
+struct CreateCommentReq {
+    1: i64 post_id,
+    2: string content,
+    3: optional i64 parent_id,     // missing or 0 = top-level comment
+}
+
+struct CreateCommentResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: Comment comment;
+}
+
+//delete------------------------------------------------------------
+// allowed for the comment author and the post author.
+// deleting a top-level comment also deletes all of its replies.
+struct DeleteCommentReq {
+    1: i64 id;
+}
+
+struct DeleteCommentResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+}
+
+//list--------------------------------------------------------------
+// top-level comments of a post, oldest first
+// cursor: 0 for first page, then next_cursor of previous page
+struct GetPostCommentsReq {
+    1: i64 post_id;
+    2: i64 cursor;
+    3: i32 limit;
+}
+
+struct GetPostCommentsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Comment> comments;
+    4: i64 next_cursor;     // 0 when no more data
+    5: bool has_more;
+}
+
+// replies of a top-level comment, oldest first
+struct GetCommentRepliesReq {
+    1: i64 comment_id;
+    2: i64 cursor;
+    3: i32 limit;
+}
+
+struct GetCommentRepliesResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Comment> comments;
+    4: i64 next_cursor;     // 0 when no more data
+    5: bool has_more;
+}
+
+//like--------------------------------------------------------------
+struct LikeCommentReq {
+    1: i64 comment_id;
+}
+
+struct UnlikeCommentReq {
+    1: i64 comment_id;
+}
+
+struct UserFlagCommentResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+}
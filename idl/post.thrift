@@ -1,5 +1,42 @@
 namespace go post
 
+include "user.thrift"
+
+//poll---------------------------------------------------------------
+struct PollOption {
+    1: i64 id,
+    2: string text,
+    3: i64 vote_count,
+}
+
+struct Poll {
+    1: string question,
+    2: list<PollOption> options,   // in the order they were created
+    3: bool multi_choice,
+    4: optional string closes_at,  // not set means never closes
+    5: bool closed,
+    6: i64 total_voters,
+    7: bool voted,                 // whether the viewer has voted
+    8: list<i64> my_option_ids,    // options the viewer voted for
+}
+
+// poll attached to a new post
+struct PollInput {
+    1: string question,
+    2: list<string> options,       // 2 ~ 10
+    3: bool multi_choice,
+    4: optional string closes_at,  // RFC3339, must be in the future
+}
+
+// an @username in content that links to a user
+// start / end are rune (unicode code point) offsets of the token, '@' included: content[start:end]
+struct MentionSpan {
+    1: i64 user_id,
+    2: string username,
+    3: i32 start,
+    4: i32 end,
+}
+
 // RFC3339Nano time strings, like "2025-11-03T00:12:34.123456789Z"
 struct Post {
     1: i64 id,
@@ -26,7 +63,7 @@ struct Post {
     17: i32 fav_count,
     18: i32 view_count,
     19: i32 comment_count,
-    20: i32 share_count,
+    20: i32 share_count,           // number of published posts quoting this one
     21: i64 last_comment_at,       // timestamp of last comment
     22: i64 hot_score,             // sort score for hot posts
 
@@ -35,6 +72,38 @@ struct Post {
 
     32: i64 category_id,
     33: string category_name,
+
+    34: bool edited,               // edit_count > 0
+    35: i32 edit_count,            // earlier versions: /post/revisions
+
+    36: string status,             // "draft" / "scheduled" / "published"
+    37: optional string publish_at,
+
+    38: string visibility,         // "public" / "followers" / "school"
+    39: bool unavailable,          // quoted post the viewer cannot see: only id / visibility are set
+
+    40: optional Poll poll,
+
+    // rounded to ~100m for privacy; location stays the display name
+    41: optional double latitude,
+    42: optional double longitude,
+    43: optional double distance_m,  // only in /post/nearby: meters from the requested point
+
+    44: list<MentionSpan> mentions,  // ordered by start
+
+    45: bool hidden,                 // hidden by moderation, only the author still sees it
+    46: bool is_pinned,              // pinned to the author's profile (first on /post/personal)
+}
+
+// an earlier version of a post, replaced by an edit
+struct PostRevision {
+    1: i64 id,
+    2: i64 post_id,
+    3: i32 version,                // 0 = original, n = after the n-th edit
+    4: string title,
+    5: string content,
+    6: list<string> tags,
+    7: string replaced_at,         // when this version was replaced by the next one
 }
 
 //get--------------------------------------------------------------
@@ -48,6 +117,19 @@ struct GetPostByIDResp {
     3: optional Post post;
 }
 
+// several posts at once (notifications, bookmarks, deep links); views are not counted
+struct BatchGetPostsReq {
+    1: list<i64> ids;   // at most 50
+}
+
+struct BatchGetPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;              // in the order of ids, duplicates removed
+    4: map<i64, Post> quoted_posts;
+    5: list<i64> missing_ids;         // not found, not published, or not visible to the viewer
+}
+
 //create------------------------------------------------------------
 struct CreatePostReq {
     1: i64 user_id,
@@ -62,6 +144,10 @@ struct CreatePostReq {
     8: optional string media_type,    // 允许前端不传，后端默认 "text"
     9: optional list<string> media_urls,
     10: optional i64 reply_to,
+    11: optional string visibility,  // "public"(默认) / "followers" / "school"
+    12: optional PollInput poll,
+    13: optional double latitude,    // both or neither, rounded before saving
+    14: optional double longitude,
 }
 
 struct CreatePostResp {
@@ -71,11 +157,93 @@ struct CreatePostResp {
 }
 
 
+//drafts------------------------------------------------------------
+// a draft is only visible to its author.
+// publish_at set (RFC3339, in the future) => "scheduled", published automatically at that time;
+// publish_at empty => "draft", published only by /post/draft/publish.
+struct SaveDraftReq {
+    1: i64 school_id,
+    2: string title,
+    3: string content,
+    4: optional i64 category_id,
+    5: optional string location,
+    6: optional list<string> tags,
+    7: optional string media_type,    // 默认 "text"
+    8: optional list<string> media_urls,
+    9: optional i64 reply_to,
+    10: optional string publish_at,
+    11: optional string visibility,
+}
+
+struct SaveDraftResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: Post post;
+}
+
+// replaces every field of a draft / scheduled post (same fields as SaveDraftReq)
+struct UpdateDraftReq {
+    1: i64 id,
+    2: i64 school_id,
+    3: string title,
+    4: string content,
+    5: optional i64 category_id,
+    6: optional string location,
+    7: optional list<string> tags,
+    8: optional string media_type,
+    9: optional list<string> media_urls,
+    10: optional i64 reply_to,
+    11: optional string publish_at,
+    12: optional string visibility,
+}
+
+struct UpdateDraftResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: Post post;
+}
+
+// publish a draft / scheduled post right now
+struct PublishDraftReq {
+    1: i64 id;
+}
+
+struct PublishDraftResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: Post post;
+}
+
+// the caller's drafts and scheduled posts, latest updated first
+struct GetMyDraftsReq {
+    1: i32 limit;
+}
+
+struct GetMyDraftsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+}
+
+//vote--------------------------------------------------------------
+// one vote per user, cannot be changed; single choice polls take exactly one option
+struct VotePollReq {
+    1: i64 post_id;
+    2: list<i64> option_ids;
+}
+
+struct VotePollResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: Poll poll;
+}
+
 //edit--------------------------------------------------------------
 struct EditPostReq {
     1: i64 id;
     2: optional string title;
     3: optional string content;
+    4: optional list<string> tags;   // not set means tags are unchanged
 }
 
 struct EditPostResp {
@@ -83,7 +251,20 @@ struct EditPostResp {
     2: string errorMessage;
     3: Post post;
 }
+
+//revisions---------------------------------------------------------
+struct GetPostRevisionsReq {
+    1: i64 id;
+}
+
+struct GetPostRevisionsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<PostRevision> revisions;   // version ASC, current version is not included
+}
+
 //delete------------------------------------------------------------
+// delete moves the post into the trash; it is purged for real after the retention period
 struct DeletePostReq {
     1: i64 id;
 }
@@ -93,44 +274,259 @@ struct DeletePostResp {
     2: string errorMessage;
 }
 
+//trash-------------------------------------------------------------
+struct TrashedPost {
+    1: Post post;
+    2: string deleted_at;
+    3: string purge_at;      // after this time the post can no longer be restored
+}
+
+// the caller's own trashed posts, latest deleted first
+struct GetTrashedPostsReq {
+    1: i32 limit;
+}
+
+struct GetTrashedPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<TrashedPost> posts;
+}
+
+struct RestorePostReq {
+    1: i64 id;
+}
+
+struct RestorePostResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: Post post;
+}
+
 //get -----------------------------------------------------
+// posts ordered by created_at DESC (then id DESC)
+// cursor: opaque string returned as next_cursor by the previous page, empty for first page
+// before: deprecated, RFC3339 time, only used when cursor is empty
 struct GetSchoolRecentPostsReq {
     1: i64 school_id;
     2: string before;
     3: i32 limit;
+    4: string cursor;
 }
 
 struct GetSchoolRecentPostsResp {
     1: bool isSuccessful;
     2: string errorMessage;
     3: list<Post> posts;
-    4: map<i64, Post> quoted_posts; 
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+// posts the caller favorited, latest favorite first
+struct GetFavoritedPostsReq {
+    1: i32 limit;
+    2: string cursor;
+}
+
+struct GetFavoritedPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+// posts user_id liked, latest like first; user_id 0 means the caller
+struct GetLikedPostsReq {
+    1: i64 user_id;
+    2: i32 limit;
+    3: string cursor;
+}
+
+struct GetLikedPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+// users who liked post_id, latest like first
+struct GetPostLikersReq {
+    1: i64 post_id;
+    2: i32 limit;
+    3: string cursor;
+}
+
+struct GetPostLikersResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<user.SimpleUserProfile> users;
+    4: string next_cursor;   // empty when no more data
+    5: bool has_more;
+}
+
+// posts that quote (reply_to) post `id`, newest first
+struct GetPostQuotesReq {
+    1: i64 id;
+    2: i32 limit;
+    3: string cursor;
+}
+
+struct GetPostQuotesResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+// posts that mention the caller (user is taken from JWT), newest first
+struct GetMentionedPostsReq {
+    1: i32 limit;
+    2: string cursor;
+}
+
+struct GetMentionedPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+// posts around a point, nearest first
+struct GetNearbyPostsReq {
+    1: optional double lat;
+    2: optional double lng;
+    3: i32 radius;           // meters, default 2000, max 50000
+    4: i32 limit;
+    5: string cursor;
+}
+
+struct GetNearbyPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+// posts of one board (category) within a school
+// category can be given by id or by key (e.g. "housing"); id wins if both are set
+struct GetCategoryRecentPostsReq {
+    1: i64 school_id;
+    2: i64 category_id;
+    3: string category_key;
+    4: string before;
+    5: i32 limit;
+    6: string cursor;
+}
+
+struct GetCategoryRecentPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
 }
 
 struct GetPersonalRecentPostsReq {
     1: i64 user_id;
     2: string before;
     3: i32 limit;
+    4: string cursor;
 }
 
 struct GetPersonalRecentPostsResp {
     1: bool isSuccessful;
     2: string errorMessage;
     3: list<Post> posts;
-    4: map<i64, Post> quoted_posts; 
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
 }
 
 struct GetFollowingUsersRecentPostsReq {
     1: i64 user_id;
     2: string before;
     3: i32 limit;
+    4: string cursor;
 }
 
 struct GetFollowingUsersRecentPostsResp {
     1: bool isSuccessful;
     2: string errorMessage;
     3: list<Post> posts;
-    4: map<i64, Post> quoted_posts; 
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+//hot -----------------------------------------------------
+// posts ordered by hot_score DESC (then id DESC)
+// cursor: opaque string returned as next_cursor by the previous page, empty for first page
+// the first page fixes a snapshot: later pages leave out posts published after it; scores keep
+// changing, so a post can move across a page boundary (shown twice or skipped) until the next refresh
+struct GetSchoolHotPostsReq {
+    1: i64 school_id;
+    2: string cursor;
+    3: i32 limit;
+}
+
+struct GetSchoolHotPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+// cross-school hot posts
+struct GetHotPostsReq {
+    1: string cursor;
+    2: i32 limit;
+}
+
+struct GetHotPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+//search -----------------------------------------------------
+// full-text search over title / content / tags, ranked by relevance.
+// every filter is optional, 0 / "" means not filtered.
+// when query is empty, results are filtered only and ordered by created_at DESC.
+struct SearchPostsReq {
+    1: string query;
+    2: i64 school_id;
+    3: i64 category_id;
+    4: i64 user_id;          // author
+    5: string tag;
+    6: string start_time;    // RFC3339, inclusive
+    7: string end_time;      // RFC3339, exclusive
+    8: string cursor;        // next_cursor of previous page, empty for first page
+    9: i32 limit;
+}
+
+struct SearchPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<Post> posts;
+    4: map<i64, Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
 }
 
 struct LikePostReq {
@@ -160,4 +556,110 @@ struct UploadPostMediaResp {
   1: bool isSuccessful,
   2: string errorMessage,
   3: list<string> urls,  // 后端已经上传完 S3 的最终访问地址
-}
\ No newline at end of file
+}
+//report / moderation -------------------------------------------------
+// reason: spam / harassment / hate / sexual / violence / misinformation / other
+struct ReportPostReq {
+    1: i64 post_id;
+    2: string reason;
+    3: string detail;   // optional, at most 500 characters
+}
+
+struct ReportPostResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+}
+
+// a post with pending reports, for moderators
+struct ReportedPost {
+    1: i64 post_id;
+    2: i64 author_id;
+    3: string title;
+    4: string content;
+    5: bool hidden;
+    6: bool deleted;                  // in the author's trash (or removed by a moderator)
+    7: i32 pending_reports;
+    8: map<string, i32> reasons;      // reason -> number of pending reports
+    9: string first_reported_at;      // RFC3339
+}
+
+// oldest first
+struct GetPendingReportsReq {
+    1: i32 limit;
+    2: string cursor;
+}
+
+struct GetPendingReportsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<ReportedPost> posts;
+    4: string next_cursor;   // empty when no more data
+    5: bool has_more;
+}
+
+// resolves every pending report of post_id
+// action: dismiss (unhides the post) / hide / delete (hide + remove, the author can't restore it) / warn (warn the author)
+// the author sees every action on their posts (with the note) through /post/moderation/mine
+struct ResolvePostReportsReq {
+    1: i64 post_id;
+    2: string action;
+    3: string note;
+}
+
+struct ResolvePostReportsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: i32 resolved;   // number of reports resolved
+}
+
+// audit trail entry; moderator_id 0 means the system (auto hide)
+struct ModerationAction {
+    1: i64 id;
+    2: i64 moderator_id;
+    3: i64 post_id;
+    4: i64 target_user_id;
+    5: string action;
+    6: string note;
+    7: string created_at;
+}
+
+// newest first; post_id 0 means all posts
+struct GetModerationActionsReq {
+    1: i64 post_id;
+    2: string cursor;   // empty for first page
+    3: i32 limit;
+}
+
+struct GetModerationActionsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<ModerationAction> actions;
+    4: string next_cursor;   // empty when no more data
+    5: bool has_more;
+}
+
+// moderation actions on the caller's own posts, newest first; moderator_id is always 0 (not disclosed)
+struct GetMyModerationActionsReq {
+    1: string cursor;   // empty for first page
+    2: i32 limit;
+}
+
+//pin to profile -------------------------------------------------------
+// a user pins up to 3 of their own published posts
+struct PinPostReq {
+    1: i64 post_id;
+}
+
+struct PinPostResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+}
+
+struct UnpinPostReq {
+    1: i64 post_id;
+}
+
+struct UnpinPostResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+}
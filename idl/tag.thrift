@@ -0,0 +1,43 @@
+namespace go tag
+
+include "post.thrift"
+
+struct TrendingTag {
+    1: i64 id,
+    2: string name,
+    3: i64 post_count,   // posts using the tag in the current window
+    4: i64 prev_count,   // posts using the tag in the previous window
+    5: i64 growth,       // post_count - prev_count
+}
+
+//posts of a tag ---------------------------------------------------
+struct GetTagPostsReq {
+    1: string name;          // with or without leading '#', case insensitive
+    2: i64 school_id;        // 0 means all schools
+    3: string before;        // deprecated, use cursor
+    4: i32 limit;
+    5: string cursor;
+}
+
+struct GetTagPostsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<post.Post> posts;
+    4: map<i64, post.Post> quoted_posts;
+    5: string next_cursor;   // empty when no more data
+    6: bool has_more;
+}
+
+//trending ---------------------------------------------------------
+// tags ordered by growth over a sliding window (last window_hours vs the window before)
+struct GetTrendingTagsReq {
+    1: i64 school_id;        // 0 means all schools
+    2: i32 window_hours;     // default 24, max 168
+    3: i32 limit;
+}
+
+struct GetTrendingTagsResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: list<TrendingTag> tags;
+}
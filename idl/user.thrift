@@ -202,6 +202,28 @@ struct ResetPasswordResp {
 
 
 
+// the viewer's own school, used by school-only posts
+struct UpdateSchoolReq {
+    1: i64 schoolId (api.body="school_id");   // 0 clears it
+}
+
+struct UpdateSchoolResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: i64 schoolId;
+}
+
+// privacy settings of the viewer
+struct UpdatePrivacyReq {
+    1: bool showLikedPosts (api.body="show_liked_posts");   // others can list my liked posts (/post/liked)
+}
+
+struct UpdatePrivacyResp {
+    1: bool isSuccessful;
+    2: string errorMessage;
+    3: bool showLikedPosts;
+}
+
 //place holder
 //body里，avatar=要上传的文件
 struct UpdateAvatarReq {
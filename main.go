@@ -1,12 +1,20 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	"zetian-personal-website-hertz/biz/config"
+	"zetian-personal-website-hertz/biz/middleware"
 	SES_email "zetian-personal-website-hertz/biz/pkg/SES_email"
 	"zetian-personal-website-hertz/biz/pkg/s3uploader"
 	"zetian-personal-website-hertz/biz/repository"
 	"zetian-personal-website-hertz/biz/repository/category_repo"
 	"zetian-personal-website-hertz/biz/repository/school_repo"
+	"zetian-personal-website-hertz/biz/service/hot_score_service"
+	"zetian-personal-website-hertz/biz/service/post_service"
+	"zetian-personal-website-hertz/biz/service/reconcile_service"
+	"zetian-personal-website-hertz/biz/service/view_service"
 
 	"github.com/cloudwego/hertz/pkg/app/server"
 	"github.com/hertz-contrib/cors"
@@ -15,16 +23,25 @@ import (
 func main() {
 	config.InitConfig() //初始化配置
 	repository.InitPostgres() //初始化数据库
+	if err := repository.AutoMigrate(); err != nil { //建表 / 加列 / 加索引（见 biz/domain 的 gorm tag）
+		log.Fatalf("%v", err)
+	}
 	SES_email.InitSES() //初始化SES 发邮件服务
 	
 	school_repo.InitSchoolCache() //初始化school缓存
 	category_repo.InitCategoryCache()	//初始化category缓存
 	s3uploader.InitS3Uploader() //初始化S3上传服务
+	hot_score_service.StartHotScoreScheduler() //后台定时计算 hot_score
+	post_service.StartTrashPurger() //后台清理超过保留期的回收站帖子
+	post_service.StartDraftPublisher() //后台发布到点的定时帖子
+	view_service.StartViewFlusher() //后台批量写入浏览量
+	reconcile_service.StartReconciler() //后台定期用关系表校正点赞 / 收藏 / 关注计数
 
 	
 	
 	h := server.New(server.WithMaxRequestBodySize(16 << 20 * 20))
 	//max 320MB (single picture 16MB, max 20 pictures)
+	h.SetClientIPFunc(middleware.ClientIPFunc()) //只信任 trusted_proxies 发来的 X-Forwarded-For，限流按真实 IP
 	h.Use(
 		cors.New(cors.Config{
 		AllowOrigins: []string{
@@ -34,10 +51,17 @@ func main() {
 		},
 		AllowMethods:     []string{"GET", "POST", "OPTIONS"},
 		AllowHeaders:     []string{"Content-Type", "Authorization"},
-		ExposeHeaders:    []string{"Content-Length"},
+		ExposeHeaders:    []string{"Content-Length", "Retry-After"}, // Retry-After: 限流（429）时前端需要读
 		AllowCredentials: true, // 允许跨域携带 Cookie
 	}))
 	register(h)
 
+	// 退出前把内存里还没写入的浏览量刷到数据库
+	h.OnShutdown = append(h.OnShutdown, func(ctx context.Context) {
+		if err := view_service.Flush(ctx); err != nil {
+			log.Printf("view flusher: flush on shutdown failed: %v", err)
+		}
+	})
+
 	h.Spin()
 }
\ No newline at end of file